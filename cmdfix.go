@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+
+	"skillguard/fix"
+)
+
+// runFix implements `skillguard fix <file.go>`: it runs every
+// registered Fixer over the file and either prints the whole rewritten
+// file to stdout or, with --write, rewrites the file in place. It does
+// not produce a unified diff.
+func runFix(args []string) {
+	fs := flag.NewFlagSet("fix", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: skillguard fix [flags] <file.go>\n")
+		fs.PrintDefaults()
+	}
+	write := fs.Bool("write", false, "rewrite the file in place instead of printing the rewritten source")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	target := fs.Arg(0)
+
+	src, err := os.ReadFile(target)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard fix: %v\n", err)
+		os.Exit(1)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, target, src, parser.ParseComments)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard fix: %v\n", err)
+		os.Exit(1)
+	}
+
+	fixes := fix.RunAll(fset, file)
+	if len(fixes) == 0 {
+		fmt.Println("skillguard fix: no fixable findings")
+		return
+	}
+
+	fixed, err := fix.Apply(fset, src, fixes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard fix: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *write {
+		if err := os.WriteFile(target, fixed, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "skillguard fix: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("skillguard fix: applied %d fix(es) to %s\n", len(fixes), target)
+		return
+	}
+
+	os.Stdout.Write(fixed)
+}
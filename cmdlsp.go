@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"skillguard/lsp"
+)
+
+// runLSP implements `skillguard lsp`: it speaks the Language Server
+// Protocol over stdin/stdout so an editor can get SkillGuard
+// diagnostics live, without shelling out to the CLI on every save.
+func runLSP(args []string) {
+	fs := flag.NewFlagSet("lsp", flag.ExitOnError)
+	logPath := fs.String("log", "", "write server logs to this file instead of stderr")
+	fs.Parse(args)
+
+	logger := log.New(os.Stderr, "skillguard-lsp: ", log.LstdFlags)
+	if *logPath != "" {
+		f, err := os.OpenFile(*logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skillguard lsp: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		logger = log.New(f, "", log.LstdFlags)
+	}
+
+	server := lsp.NewServer(os.Stdin, os.Stdout, logger)
+	if err := server.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard lsp: %v\n", err)
+		os.Exit(1)
+	}
+}
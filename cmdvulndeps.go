@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"skillguard/vulndeps"
+)
+
+// runVulndeps implements `skillguard vulndeps <project-dir>`: it
+// parses the project's go.mod, checks every dependency against OSV,
+// and (unless disabled) narrows the result to advisories that are
+// actually reachable from main.
+func runVulndeps(args []string) {
+	fs := flag.NewFlagSet("vulndeps", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: skillguard vulndeps [flags] <project-dir>\n")
+		fs.PrintDefaults()
+	}
+	offlineDB := fs.String("offline-db", "", "path to a local OSV database dump; queries api.osv.dev when unset")
+	noReachability := fs.Bool("no-reachability", false, "skip the call-graph reachability pass and report every matching advisory")
+	format := fs.String("format", "text", "output format: text or sarif")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	dir := fs.Arg(0)
+
+	modules, err := vulndeps.ParseGoMod(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard vulndeps: %v\n", err)
+		os.Exit(1)
+	}
+
+	var advisories []vulndeps.Advisory
+	if *offlineDB != "" {
+		advisories, err = vulndeps.QueryOffline(modules, *offlineDB)
+	} else {
+		advisories, err = vulndeps.QueryOnline(modules)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard vulndeps: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !*noReachability {
+		advisories, err = vulndeps.MarkReachable(dir, advisories)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skillguard vulndeps: reachability pass: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	report(advisoriesToFindings(advisories), *format)
+}
+
+// advisoriesToFindings folds OSV advisories into the same Finding
+// shape the source-level detectors produce, so `vulndeps` output
+// flows through the same text/SARIF reporters.
+func advisoriesToFindings(advisories []vulndeps.Advisory) []Finding {
+	findings := make([]Finding, 0, len(advisories))
+	for _, a := range advisories {
+		if a.Checkable && !a.Reachable {
+			continue
+		}
+		msg := fmt.Sprintf("%s %s@%s: %s", a.ID, a.Module.Path, a.Module.Version, a.Summary)
+		if a.FixedIn != "" {
+			msg += fmt.Sprintf(" (fixed in %s)", a.FixedIn)
+		}
+		findings = append(findings, Finding{
+			RuleID:   "SG-VULNDEP-001",
+			Severity: SeverityHigh,
+			Message:  msg,
+			File:     "go.mod",
+		})
+	}
+	return findings
+}
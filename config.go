@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the parsed form of skillguard.yml: per-rule overrides and
+// path filters applied after the detectors run.
+type Config struct {
+	Rules   map[string]RuleOverride `yaml:"rules"`
+	Include []string                `yaml:"include"`
+	Exclude []string                `yaml:"exclude"`
+}
+
+// RuleOverride holds a per-rule enable/disable flag and/or severity
+// override from skillguard.yml. A nil Enabled leaves the rule at its
+// catalogue default.
+type RuleOverride struct {
+	Enabled  *bool  `yaml:"enabled"`
+	Severity string `yaml:"severity"`
+}
+
+// LoadConfig reads and parses a skillguard.yml file. A missing file
+// is not an error: it returns an empty Config so callers can always
+// apply it unconditionally.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Apply filters and re-severities findings according to the config's
+// rule overrides, and drops findings from files that don't match
+// Include/Exclude.
+func (c *Config) Apply(findings []Finding) []Finding {
+	out := findings[:0]
+	for _, f := range findings {
+		if !c.pathAllowed(f.File) {
+			continue
+		}
+		override, has := c.Rules[f.RuleID]
+		if has {
+			if override.Enabled != nil && !*override.Enabled {
+				continue
+			}
+			if override.Severity != "" {
+				f.Severity = Severity(override.Severity)
+			}
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+func (c *Config) pathAllowed(file string) bool {
+	if len(c.Include) > 0 && !matchesAny(c.Include, file) {
+		return false
+	}
+	if matchesAny(c.Exclude, file) {
+		return false
+	}
+	return true
+}
+
+func matchesAny(globs []string, file string) bool {
+	for _, g := range globs {
+		if ok, _ := filepath.Match(g, file); ok {
+			return true
+		}
+	}
+	return false
+}
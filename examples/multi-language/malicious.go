@@ -1,4 +1,11 @@
-// Example malicious Go code for SkillGuard testing
+//go:build ignore
+
+// Example malicious Go code for SkillGuard testing. This file is
+// intentionally excluded from the module build (it's a scan target,
+// fed to the detectors via go/parser, not a package anything imports)
+// and intentionally doesn't compile cleanly — e.g. the unsafe.Pointer
+// below is never used, which is exactly the kind of dead-but-risky
+// code SkillGuard's detectors are meant to flag.
 package main
 
 import (
@@ -0,0 +1,19 @@
+// Package main implements the SkillGuard command-line scanner.
+package main
+
+import "skillguard/scan"
+
+// Finding and Severity are re-exported from skillguard/scan so the
+// rest of this package (config.go, suppress.go, rulecatalogue.go, the
+// cmd*.go subcommands) can keep referring to them unqualified; the
+// CLI and the LSP server in skillguard/lsp both build on the same
+// scan.Finding stream.
+type Finding = scan.Finding
+type Severity = scan.Severity
+
+const (
+	SeverityLow      = scan.SeverityLow
+	SeverityMedium   = scan.SeverityMedium
+	SeverityHigh     = scan.SeverityHigh
+	SeverityCritical = scan.SeverityCritical
+)
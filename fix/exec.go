@@ -0,0 +1,63 @@
+package fix
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	Register(execDispatchFixer{})
+}
+
+// execDispatchFixer rewrites `exec.Command("sh", "-c", x)` (or
+// "bash"/"-c") into a call to safeexec.Dispatch, so the argument goes
+// through an allowlist instead of a shell.
+type execDispatchFixer struct{}
+
+func (execDispatchFixer) RuleID() string { return "SG-EXEC-001" }
+
+func (f execDispatchFixer) SuggestFixes(fset *token.FileSet, file *ast.File) []SuggestedFix {
+	var fixes []SuggestedFix
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok || pkgIdent.Name != "exec" || sel.Sel.Name != "Command" || len(call.Args) != 3 {
+			return true
+		}
+		if !isShellLiteral(call.Args[0]) || !isDashCLiteral(call.Args[1]) {
+			return true
+		}
+
+		userArg := exprText(fset, call.Args[2])
+		fixes = append(fixes, SuggestedFix{
+			RuleID:  f.RuleID(),
+			Message: "dispatch through an allowlist instead of a shell",
+			Edits: []Edit{{
+				Pos:     call.Pos(),
+				End:     call.End(),
+				NewText: "safeexec.Dispatch(" + userArg + ")",
+			}},
+		})
+		return true
+	})
+
+	return fixes
+}
+
+func isShellLiteral(arg ast.Expr) bool {
+	lit, ok := arg.(*ast.BasicLit)
+	return ok && (lit.Value == `"sh"` || lit.Value == `"bash"`)
+}
+
+func isDashCLiteral(arg ast.Expr) bool {
+	lit, ok := arg.(*ast.BasicLit)
+	return ok && lit.Value == `"-c"`
+}
@@ -0,0 +1,182 @@
+// Package fix implements SkillGuard's auto-fix mode: a small set of
+// Fixers, each tied to a rule ID, that propose textual rewrites for
+// findings with an obvious safe replacement. Unlike the detectors in
+// skillguard/rules, a Fixer doesn't decide whether something is
+// wrong — it assumes the matching rule already fired and just knows
+// how to rewrite the pattern it recognizes.
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Edit is a single source rewrite: replace the bytes between Pos and
+// End with NewText.
+type Edit struct {
+	Pos, End token.Pos
+	NewText  string
+}
+
+// SuggestedFix is one Fixer's proposed rewrite for a single match.
+type SuggestedFix struct {
+	RuleID  string
+	Message string
+	Edits   []Edit
+}
+
+// Fixer proposes rewrites for findings from one rule. Not every rule
+// has a Fixer registered: a rule with no single safe rewrite (e.g.
+// SG-MUX-CONNECT-001, whose fix depends on the handler body) simply
+// never appears here.
+type Fixer interface {
+	RuleID() string
+	SuggestFixes(fset *token.FileSet, file *ast.File) []SuggestedFix
+}
+
+var registry []Fixer
+
+// Register adds a Fixer to the catalogue. Fixer implementations call
+// this from an init() in their own file.
+func Register(f Fixer) {
+	registry = append(registry, f)
+}
+
+// All returns every registered Fixer.
+func All() []Fixer {
+	return registry
+}
+
+// RunAll runs every registered Fixer against file and returns their
+// combined suggestions.
+func RunAll(fset *token.FileSet, file *ast.File) []SuggestedFix {
+	var fixes []SuggestedFix
+	for _, f := range registry {
+		fixes = append(fixes, f.SuggestFixes(fset, file)...)
+	}
+	return fixes
+}
+
+// Apply rewrites src with every edit from fixes applied, then runs
+// the result through go/format so the output reads like hand-written
+// Go regardless of how the edits were spliced in, and finally through
+// fixImports so a rewrite that introduces a new package reference
+// (e.g. safeexec.Dispatch) or removes the only use of an existing one
+// still compiles without a manual goimports pass.
+func Apply(fset *token.FileSet, src []byte, fixes []SuggestedFix) ([]byte, error) {
+	var edits []Edit
+	for _, f := range fixes {
+		edits = append(edits, f.Edits...)
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Pos < edits[j].Pos })
+
+	var out bytes.Buffer
+	offset := 0
+	for _, e := range edits {
+		start := fset.Position(e.Pos).Offset
+		end := fset.Position(e.End).Offset
+		if start < offset {
+			return nil, fmt.Errorf("fix: overlapping edits at offset %d", start)
+		}
+		out.Write(src[offset:start])
+		out.WriteString(e.NewText)
+		offset = end
+	}
+	out.Write(src[offset:])
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return fixImports(formatted)
+}
+
+// knownFixImports maps the package identifiers SkillGuard's fixers
+// might introduce into rewritten code to their import path. A Fixer
+// only edits expression text, so this is how Apply knows what import
+// to add when a rewrite starts referencing one of these packages.
+var knownFixImports = map[string]string{
+	"safeexec": "skillguard/fix/safeexec",
+	"filepath": "path/filepath",
+}
+
+// fixImports adds any knownFixImports package a rewrite now uses and
+// removes any existing import that is no longer referenced, the way
+// goimports would, so Apply's output always compiles on its own.
+func fixImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing rewritten source for import fixup: %w", err)
+	}
+
+	used := usedPackageIdents(file)
+	for name, path := range knownFixImports {
+		if used[name] {
+			astutil.AddImport(fset, file, path)
+		}
+	}
+	for _, imp := range file.Imports {
+		name := importLocalName(imp)
+		if name == "_" || name == "." || used[name] {
+			continue
+		}
+		astutil.DeleteImport(fset, file, strings.Trim(imp.Path.Value, `"`))
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("rendering import fixup: %w", err)
+	}
+	return format.Source(buf.Bytes())
+}
+
+// usedPackageIdents collects the identifier half of every X.Sel
+// selector expression in file, which covers every way a package
+// qualifier is referenced.
+func usedPackageIdents(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			used[ident.Name] = true
+		}
+		return true
+	})
+	return used
+}
+
+// importLocalName returns the identifier code in file uses to refer
+// to imp: its explicit alias, or the last path element otherwise.
+func importLocalName(imp *ast.ImportSpec) string {
+	if imp.Name != nil {
+		return imp.Name.Name
+	}
+	path := strings.Trim(imp.Path.Value, `"`)
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+// exprText renders n back to source text, for splicing an existing
+// expression into a replacement call.
+func exprText(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		return ""
+	}
+	return buf.String()
+}
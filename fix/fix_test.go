@@ -0,0 +1,94 @@
+package fix_test
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+
+	"skillguard/fix"
+)
+
+// TestSuggestFixesGolden runs every registered Fixer against each
+// testdata/fix/*.go.in file and checks the result against the
+// matching *.go.out file.
+func TestSuggestFixesGolden(t *testing.T) {
+	matches, err := filepath.Glob("testdata/fix/*.go.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) == 0 {
+		t.Fatal("no testdata/fix/*.go.in fixtures found")
+	}
+
+	for _, in := range matches {
+		in := in
+		t.Run(filepath.Base(in), func(t *testing.T) {
+			src, err := os.ReadFile(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantPath := in[:len(in)-len(".in")] + ".out"
+			want, err := os.ReadFile(wantPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, in, src, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("parsing %s: %v", in, err)
+			}
+
+			fixes := fix.RunAll(fset, file)
+			if len(fixes) == 0 {
+				t.Fatalf("no fixer matched %s", in)
+			}
+
+			got, err := fix.Apply(fset, src, fixes)
+			if err != nil {
+				t.Fatalf("applying fixes to %s: %v", in, err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("%s: got:\n%s\nwant:\n%s", in, got, want)
+			}
+			assertCompiles(t, filepath.Base(in), got)
+		})
+	}
+}
+
+// assertCompiles type-checks src in its own scratch package directory
+// (so its declarations can never collide with a sibling fixture's)
+// to confirm a golden file isn't just a byte-for-byte match but
+// actually valid, buildable Go.
+func assertCompiles(t *testing.T, name string, src []byte) {
+	t.Helper()
+
+	dir := filepath.Join("testdata", "fix", "compilecheck", name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("creating compile check dir: %v", err)
+	}
+	defer os.RemoveAll(filepath.Join("testdata", "fix", "compilecheck"))
+
+	checkFile := filepath.Join(dir, "check.go")
+	if err := os.WriteFile(checkFile, src, 0644); err != nil {
+		t.Fatalf("writing compile check file: %v", err)
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+			packages.NeedImports | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, "file="+checkFile)
+	if err != nil {
+		t.Fatalf("loading %s for compile check: %v", checkFile, err)
+	}
+	for _, p := range pkgs {
+		for _, e := range p.Errors {
+			t.Errorf("%s does not compile after fix.Apply: %v", name, e)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package fix
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	Register(pathGuardFixer{})
+}
+
+// pathGuardFixer wraps a tainted path argument to os.Open/os.ReadFile
+// in filepath.Clean. It only normalises the path; callers that need a
+// hard base-directory boundary still have to add their own
+// strings.HasPrefix check, since this Fixer has no way to know what
+// that base directory should be.
+type pathGuardFixer struct{}
+
+func (pathGuardFixer) RuleID() string { return "SG-G304" }
+
+var fileReadCalls = map[string]bool{
+	"os.Open":         true,
+	"os.ReadFile":     true,
+	"ioutil.ReadFile": true,
+}
+
+func (f pathGuardFixer) SuggestFixes(fset *token.FileSet, file *ast.File) []SuggestedFix {
+	var fixes []SuggestedFix
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok || !fileReadCalls[pkgIdent.Name+"."+sel.Sel.Name] || len(call.Args) == 0 {
+			return true
+		}
+		if _, literal := call.Args[0].(*ast.BasicLit); literal {
+			return true
+		}
+		if alreadyCleaned(call.Args[0]) {
+			return true
+		}
+
+		arg := exprText(fset, call.Args[0])
+		fixes = append(fixes, SuggestedFix{
+			RuleID:  f.RuleID(),
+			Message: "normalise the path with filepath.Clean before opening it",
+			Edits: []Edit{{
+				Pos:     call.Args[0].Pos(),
+				End:     call.Args[0].End(),
+				NewText: "filepath.Clean(" + arg + ")",
+			}},
+		})
+		return true
+	})
+
+	return fixes
+}
+
+func alreadyCleaned(arg ast.Expr) bool {
+	call, ok := arg.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "filepath" && sel.Sel.Name == "Clean"
+}
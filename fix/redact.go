@@ -0,0 +1,120 @@
+package fix
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Register(redactURLFixer{})
+}
+
+// redactURLFixer rewrites an http.Get/Post/PostForm call whose URL
+// argument concatenates a literal base with os.Getenv("X") into a
+// call to safeexec.RedactSecretURL, so the secret's value never ends
+// up in an outbound request (or a log line built from the same
+// expression) in the clear.
+type redactURLFixer struct{}
+
+func (redactURLFixer) RuleID() string { return "SG-NET-EXFIL-001" }
+
+var urlCalls = map[string]bool{"Get": true, "Post": true, "PostForm": true}
+
+func (f redactURLFixer) SuggestFixes(fset *token.FileSet, file *ast.File) []SuggestedFix {
+	var fixes []SuggestedFix
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok || pkgIdent.Name != "http" || !urlCalls[sel.Sel.Name] || len(call.Args) == 0 {
+			return true
+		}
+
+		base, param, envVar, ok := secretURLConcat(call.Args[0])
+		if !ok {
+			return true
+		}
+
+		fixes = append(fixes, SuggestedFix{
+			RuleID:  f.RuleID(),
+			Message: "redact the secret before building the outbound URL",
+			Edits: []Edit{{
+				Pos:     call.Args[0].Pos(),
+				End:     call.Args[0].End(),
+				NewText: "safeexec.RedactSecretURL(" + base + `, "` + param + `", os.Getenv(` + strconv.Quote(envVar) + `))`,
+			}},
+		})
+		return true
+	})
+
+	return fixes
+}
+
+// secretURLConcat matches `"literal" + os.Getenv("X")` where literal
+// ends in a query-string key (e.g. "...?data=" or "...&token="), and
+// returns the literal (still quoted, as source text), the query
+// parameter name the secret fills in, and the env var name. It
+// refuses the match (ok == false) when the literal doesn't end in a
+// recognisable "key=" so the fix never guesses a parameter name.
+func secretURLConcat(expr ast.Expr) (base, param, envVar string, ok bool) {
+	bin, isBin := expr.(*ast.BinaryExpr)
+	if !isBin || bin.Op.String() != "+" {
+		return "", "", "", false
+	}
+	lit, isLit := bin.X.(*ast.BasicLit)
+	if !isLit {
+		return "", "", "", false
+	}
+	call, isCall := bin.Y.(*ast.CallExpr)
+	if !isCall {
+		return "", "", "", false
+	}
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !isSel {
+		return "", "", "", false
+	}
+	pkg, isIdent := sel.X.(*ast.Ident)
+	if !isIdent || pkg.Name != "os" || sel.Sel.Name != "Getenv" || len(call.Args) != 1 {
+		return "", "", "", false
+	}
+	argLit, isArgLit := call.Args[0].(*ast.BasicLit)
+	if !isArgLit {
+		return "", "", "", false
+	}
+	name, err := strconv.Unquote(argLit.Value)
+	if err != nil {
+		return "", "", "", false
+	}
+	litValue, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", "", "", false
+	}
+	paramName, ok := queryParamName(litValue)
+	if !ok {
+		return "", "", "", false
+	}
+	return lit.Value, paramName, name, true
+}
+
+// queryParamName extracts the key from a URL literal that ends right
+// after a query-string "key=", e.g. "https://x/y?data=" -> "data".
+func queryParamName(rawURL string) (string, bool) {
+	if !strings.HasSuffix(rawURL, "=") {
+		return "", false
+	}
+	trimmed := strings.TrimSuffix(rawURL, "=")
+	idx := strings.LastIndexAny(trimmed, "?&")
+	if idx == -1 || idx == len(trimmed)-1 {
+		return "", false
+	}
+	return trimmed[idx+1:], true
+}
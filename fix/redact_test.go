@@ -0,0 +1,23 @@
+package fix
+
+import "testing"
+
+func TestQueryParamName(t *testing.T) {
+	cases := []struct {
+		rawURL    string
+		wantParam string
+		wantOK    bool
+	}{
+		{"https://evil.com/exfiltrate?data=", "data", true},
+		{"https://x.com/track?user=", "user", true},
+		{"https://x.com/track?a=1&token=", "token", true},
+		{"https://x.com/track", "", false},
+		{"https://x.com/track?", "", false},
+	}
+	for _, c := range cases {
+		param, ok := queryParamName(c.rawURL)
+		if ok != c.wantOK || param != c.wantParam {
+			t.Errorf("queryParamName(%q) = (%q, %v), want (%q, %v)", c.rawURL, param, ok, c.wantParam, c.wantOK)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Package safeexec provides the small runtime helpers SkillGuard's
+// auto-fix mode rewrites call sites to use: a command dispatcher that
+// only runs allowlisted programs, and a URL builder that redacts a
+// secret before it's logged or sent onward.
+package safeexec
+
+import (
+	"log"
+	"net/url"
+	"os/exec"
+)
+
+// Allowlist maps a program name a caller may request to the binary
+// it actually runs. Callers extend it at init time for their own
+// allowed commands; SkillGuard's fixer never invents entries.
+var Allowlist = map[string]string{}
+
+// Dispatch looks up name in Allowlist and returns a Cmd for it, the
+// same way exec.Command would. It replaces a direct
+// `exec.Command("sh", "-c", name)` call: name is no longer handed to
+// a shell, so it can't smuggle in extra shell syntax, and it must
+// resolve to a program the caller explicitly allowed. A name outside
+// the allowlist logs a warning and returns a Cmd that exits non-zero,
+// so callers that only check the error from Run can still keep the
+// single-return-value call signature exec.Command has.
+func Dispatch(name string) *exec.Cmd {
+	path, ok := Allowlist[name]
+	if !ok {
+		log.Printf("safeexec: %q is not an allowlisted program", name)
+		return exec.Command("/bin/false")
+	}
+	return exec.Command(path)
+}
+
+// RedactSecretURL builds base with a query parameter, masking its
+// value so the secret itself never appears in the returned URL. It
+// replaces a direct `base + secret` string concatenation.
+func RedactSecretURL(base, param, secret string) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return base
+	}
+	q := u.Query()
+	q.Set(param, redactedValue(secret))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func redactedValue(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "REDACTED"
+}
@@ -0,0 +1,104 @@
+package fix
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Register(sqlParamFixer{})
+}
+
+// sqlParamFixer rewrites `db.Query(fmt.Sprintf("SELECT ... %s", x))`
+// into `db.Query("SELECT ... ?", x)`: the Sprintf call disappears and
+// its arguments move to the query call as bind parameters.
+type sqlParamFixer struct{}
+
+func (sqlParamFixer) RuleID() string { return "SG-G201" }
+
+var sqlQueryMethods = map[string]bool{
+	"Query": true, "QueryContext": true,
+	"Exec": true, "ExecContext": true,
+	"QueryRow": true, "QueryRowContext": true,
+}
+
+func (f sqlParamFixer) SuggestFixes(fset *token.FileSet, file *ast.File) []SuggestedFix {
+	var fixes []SuggestedFix
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !sqlQueryMethods[sel.Sel.Name] || len(call.Args) != 1 {
+			return true
+		}
+		sprintf, ok := call.Args[0].(*ast.CallExpr)
+		if !ok || len(sprintf.Args) == 0 {
+			return true
+		}
+		sprintfSel, ok := sprintf.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sprintfSel.X.(*ast.Ident)
+		if !ok || pkgIdent.Name != "fmt" || sprintfSel.Sel.Name != "Sprintf" {
+			return true
+		}
+		formatLit, ok := sprintf.Args[0].(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		paramized, ok := paramize(formatLit.Value)
+		if !ok {
+			return true
+		}
+
+		bindArgs := make([]string, 0, len(sprintf.Args)-1)
+		for _, a := range sprintf.Args[1:] {
+			bindArgs = append(bindArgs, exprText(fset, a))
+		}
+		newArgs := paramized
+		if len(bindArgs) > 0 {
+			newArgs += ", " + strings.Join(bindArgs, ", ")
+		}
+
+		fixes = append(fixes, SuggestedFix{
+			RuleID:  f.RuleID(),
+			Message: "parameterise the query instead of formatting it",
+			Edits: []Edit{{
+				Pos:     call.Args[0].Pos(),
+				End:     call.Args[0].End(),
+				NewText: newArgs,
+			}},
+		})
+		return true
+	})
+
+	return fixes
+}
+
+// paramize turns a Go string literal containing %s/%d/%v verbs into
+// one with positional ? placeholders, the form database/sql expects.
+func paramize(litValue string) (string, bool) {
+	unquoted, err := strconv.Unquote(litValue)
+	if err != nil {
+		return "", false
+	}
+	var b strings.Builder
+	for i := 0; i < len(unquoted); i++ {
+		if unquoted[i] == '%' && i+1 < len(unquoted) {
+			switch unquoted[i+1] {
+			case 's', 'd', 'v', 'q':
+				b.WriteByte('?')
+				i++
+				continue
+			}
+		}
+		b.WriteByte(unquoted[i])
+	}
+	return strconv.Quote(b.String()), true
+}
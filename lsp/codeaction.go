@@ -0,0 +1,152 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"skillguard/fix"
+)
+
+// codeActions returns the auto-fixes from skillguard/fix that apply
+// within the requested range, plus a "suppress on this line" action
+// for every diagnostic the client is asking about.
+func (s *Server) codeActions(p CodeActionParams) ([]CodeAction, error) {
+	content, ok := s.documents[p.TextDocument.URI]
+	if !ok {
+		return nil, fmt.Errorf("codeAction: %s is not open", p.TextDocument.URI)
+	}
+
+	fset := token.NewFileSet()
+	path := filePath(p.TextDocument.URI)
+	file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+	if err != nil {
+		return nil, nil // nothing to offer on an unparseable file
+	}
+
+	var actions []CodeAction
+	for _, sf := range fix.RunAll(fset, file) {
+		if !fixTouchesRange(fset, sf, p.Range) {
+			continue
+		}
+		actions = append(actions, CodeAction{
+			Title: "SkillGuard: " + sf.Message,
+			Kind:  "quickfix",
+			Edit:  &WorkspaceEdit{Changes: map[string][]TextEdit{p.TextDocument.URI: toTextEdits(fset, sf)}},
+		})
+	}
+
+	for _, d := range p.Context.Diagnostics {
+		if d.Code == "" {
+			continue
+		}
+		actions = append(actions, CodeAction{
+			Title: fmt.Sprintf("Suppress %s on this line", d.Code),
+			Kind:  "quickfix",
+			Edit:  nil, // applied via the Command below, not a direct edit
+			Command: &Command{
+				Title:   fmt.Sprintf("Suppress %s on this line", d.Code),
+				Command: SuppressLineCommand,
+				Arguments: []interface{}{
+					p.TextDocument.URI,
+					d.Range.Start.Line,
+					d.Code,
+				},
+			},
+		})
+	}
+
+	return actions, nil
+}
+
+func fixTouchesRange(fset *token.FileSet, sf fix.SuggestedFix, r Range) bool {
+	for _, e := range sf.Edits {
+		line := fset.Position(e.Pos).Line - 1
+		if line >= r.Start.Line && line <= r.End.Line {
+			return true
+		}
+	}
+	return false
+}
+
+func toTextEdits(fset *token.FileSet, sf fix.SuggestedFix) []TextEdit {
+	edits := make([]TextEdit, 0, len(sf.Edits))
+	for _, e := range sf.Edits {
+		start := fset.Position(e.Pos)
+		end := fset.Position(e.End)
+		edits = append(edits, TextEdit{
+			Range: Range{
+				Start: Position{Line: start.Line - 1, Character: start.Column - 1},
+				End:   Position{Line: end.Line - 1, Character: end.Column - 1},
+			},
+			NewText: e.NewText,
+		})
+	}
+	return edits
+}
+
+// executeCommand handles workspace/executeCommand. The only command
+// this server registers is "suppress on this line", which appends a
+// //skillguard:ignore comment to the named line and asks the client
+// to apply that edit.
+func (s *Server) executeCommand(id json.RawMessage, p ExecuteCommandParams) error {
+	if id != nil {
+		defer s.t.reply(id, nil)
+	}
+	if p.Command != SuppressLineCommand || len(p.Arguments) < 2 {
+		return nil
+	}
+
+	uri, ok := p.Arguments[0].(string)
+	if !ok {
+		return fmt.Errorf("executeCommand: first argument must be a document URI")
+	}
+	lineFloat, ok := p.Arguments[1].(float64)
+	if !ok {
+		return fmt.Errorf("executeCommand: second argument must be a 0-based line number")
+	}
+	line := int(lineFloat)
+
+	ruleID := ""
+	if len(p.Arguments) >= 3 {
+		ruleID, _ = p.Arguments[2].(string)
+	}
+
+	content, ok := s.documents[uri]
+	if !ok {
+		return fmt.Errorf("executeCommand: %s is not open", uri)
+	}
+	lines := strings.Split(string(content), "\n")
+	if line < 0 || line >= len(lines) {
+		return fmt.Errorf("executeCommand: line %d out of range", line)
+	}
+
+	comment := "//skillguard:ignore"
+	if ruleID != "" {
+		comment += " " + ruleID
+	}
+	endCol := len([]rune(lines[line]))
+
+	// workspace/applyEdit is a server->client request per the LSP
+	// spec, not a notification: spec-compliant clients only dispatch
+	// it to their request handler when the message carries an id, and
+	// otherwise silently drop it. This server doesn't wait for or
+	// correlate the client's ApplyWorkspaceEditResponse beyond
+	// handle's generic "empty Method means it's a response" check.
+	return s.t.request("workspace/applyEdit", map[string]interface{}{
+		"label": "Suppress SkillGuard finding",
+		"edit": WorkspaceEdit{
+			Changes: map[string][]TextEdit{
+				uri: {{
+					Range: Range{
+						Start: Position{Line: line, Character: endCol},
+						End:   Position{Line: line, Character: endCol},
+					},
+					NewText: " " + comment,
+				}},
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package lsp
+
+import "testing"
+
+func TestCodeActionsSuppressCommand(t *testing.T) {
+	uri := "file:///tmp/example.go"
+	content := "package example\n\nfunc run() {\n\t_ = 1\n}\n"
+
+	s := &Server{documents: map[string][]byte{uri: []byte(content)}}
+
+	actions, err := s.codeActions(CodeActionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Range:        Range{Start: Position{Line: 3}, End: Position{Line: 3}},
+		Context: CodeActionContext{
+			Diagnostics: []Diagnostic{{
+				Range: Range{Start: Position{Line: 3}, End: Position{Line: 3}},
+				Code:  "SG-ENV-001",
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var suppress *CodeAction
+	for i := range actions {
+		if actions[i].Command != nil && actions[i].Command.Command == SuppressLineCommand {
+			suppress = &actions[i]
+		}
+	}
+	if suppress == nil {
+		t.Fatalf("got %+v, want a code action whose Command is %q", actions, SuppressLineCommand)
+	}
+	if len(suppress.Command.Arguments) != 3 {
+		t.Fatalf("got %d Command.Arguments, want 3 (uri, line, ruleID)", len(suppress.Command.Arguments))
+	}
+	if suppress.Command.Arguments[0] != uri {
+		t.Errorf("Arguments[0] = %v, want uri %q", suppress.Command.Arguments[0], uri)
+	}
+	if suppress.Command.Arguments[1] != 3 {
+		t.Errorf("Arguments[1] = %v, want line 3", suppress.Command.Arguments[1])
+	}
+	if suppress.Command.Arguments[2] != "SG-ENV-001" {
+		t.Errorf("Arguments[2] = %v, want rule ID SG-ENV-001", suppress.Command.Arguments[2])
+	}
+}
@@ -0,0 +1,104 @@
+package lsp
+
+import (
+	"go/parser"
+	"go/token"
+	"net/url"
+	"strings"
+
+	"skillguard/scan"
+	"skillguard/taint"
+)
+
+// diagnose parses content and runs every SkillGuard pass over it,
+// returning the result as LSP Diagnostics. The taint pass loads the
+// surrounding package through overlay so it sees unsaved edits too.
+func diagnose(uri string, content []byte) ([]Diagnostic, error) {
+	path := filePath(uri)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+	if err != nil {
+		return []Diagnostic{parseErrorDiagnostic(fset, err)}, nil
+	}
+
+	findings := scan.ScanFile(fset, file, path)
+
+	taintFindings, err := taint.AnalyzeOverlay(path, map[string][]byte{path: content}, taint.Options{})
+	if err == nil {
+		for _, tf := range taintFindings {
+			findings = append(findings, scan.Finding{
+				RuleID:   tf.RuleID,
+				Severity: scan.Severity(tf.Severity),
+				Message:  tf.Message,
+				File:     tf.File,
+				Line:     tf.Line,
+				Column:   tf.Column,
+			})
+		}
+	}
+	// A failed taint pass (e.g. the file doesn't parse as part of a
+	// loadable package yet, mid-edit) is not fatal: the AST findings
+	// above still publish.
+
+	diagnostics := make([]Diagnostic, 0, len(findings))
+	for _, f := range findings {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    pointRange(f.Line, f.Column),
+			Severity: lspSeverity(f.Severity),
+			Code:     f.RuleID,
+			Source:   "skillguard",
+			Message:  f.Message,
+		})
+	}
+	return diagnostics, nil
+}
+
+func lspSeverity(s scan.Severity) int {
+	switch s {
+	case scan.SeverityCritical, scan.SeverityHigh:
+		return SeverityError
+	case scan.SeverityMedium:
+		return SeverityWarning
+	default:
+		return SeverityInformation
+	}
+}
+
+// pointRange turns a 1-based line/column into a zero-width LSP range
+// at that 0-based position. diagnose passes only a finding's start
+// position, so a finding's EndLine/EndColumn are not reflected in the
+// published range.
+func pointRange(line, column int) Range {
+	pos := Position{Line: max0(line - 1), Character: max0(column - 1)}
+	return Range{Start: pos, End: pos}
+}
+
+func max0(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+func parseErrorDiagnostic(fset *token.FileSet, err error) Diagnostic {
+	// go/parser's error already contains a "file:line:col: message"
+	// prefix; surface it as a single diagnostic at the top of the file
+	// rather than trying to recover a position from it.
+	_ = fset
+	return Diagnostic{
+		Range:    pointRange(1, 1),
+		Severity: SeverityError,
+		Source:   "skillguard",
+		Message:  "parse error: " + err.Error(),
+	}
+}
+
+// filePath converts a file:// URI into a plain filesystem path.
+func filePath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return strings.TrimPrefix(uri, "file://")
+	}
+	return u.Path
+}
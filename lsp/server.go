@@ -0,0 +1,165 @@
+// Package lsp wraps SkillGuard's scanners in a Language Server
+// Protocol server, so findings show up as editor diagnostics on save
+// instead of requiring a separate CLI invocation.
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+)
+
+// Server holds one client connection's state: its document overlay
+// and the transport it talks LSP over.
+type Server struct {
+	t         *transport
+	documents map[string][]byte // uri -> current (possibly unsaved) content
+	logger    *log.Logger
+}
+
+// NewServer wires a Server to read requests from r and write
+// responses/notifications to w — typically os.Stdin/os.Stdout, the
+// pipe an editor connects over stdio.
+func NewServer(r io.Reader, w io.Writer, logger *log.Logger) *Server {
+	return &Server{
+		t:         newTransport(r, w),
+		documents: make(map[string][]byte),
+		logger:    logger,
+	}
+}
+
+// Run reads and dispatches messages until the connection closes or
+// the client sends "exit".
+func (s *Server) Run() error {
+	for {
+		msg, err := s.t.readMessage()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.handle(msg); err != nil {
+			s.logf("handling %s: %v", msg.Method, err)
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+	}
+}
+
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Printf(format, args...)
+	}
+}
+
+func (s *Server) handle(msg *rpcMessage) error {
+	switch msg.Method {
+	case "initialize":
+		return s.t.reply(msg.ID, initializeResult())
+	case "initialized", "shutdown", "exit":
+		if msg.ID != nil {
+			return s.t.reply(msg.ID, nil)
+		}
+		return nil
+
+	case "textDocument/didOpen":
+		var p DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		s.documents[p.TextDocument.URI] = []byte(p.TextDocument.Text)
+		return s.publish(p.TextDocument.URI)
+
+	case "textDocument/didChange":
+		var p DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil
+		}
+		// Full-document sync only: the last change event is the whole
+		// new document text.
+		s.documents[p.TextDocument.URI] = []byte(p.ContentChanges[len(p.ContentChanges)-1].Text)
+		return s.publish(p.TextDocument.URI)
+
+	case "textDocument/didSave":
+		var p DidSaveTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		return s.publish(p.TextDocument.URI)
+
+	case "textDocument/didClose":
+		var p DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		delete(s.documents, p.TextDocument.URI)
+		return s.t.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: p.TextDocument.URI})
+
+	case "textDocument/codeAction":
+		var p CodeActionParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		actions, err := s.codeActions(p)
+		if err != nil {
+			return err
+		}
+		return s.t.reply(msg.ID, actions)
+
+	case "workspace/executeCommand":
+		var p ExecuteCommandParams
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			return err
+		}
+		return s.executeCommand(msg.ID, p)
+
+	default:
+		if msg.Method == "" {
+			// A response to a request this server sent (e.g. the
+			// client's reply to our workspace/applyEdit), not a new
+			// request to answer.
+			return nil
+		}
+		// Unknown notifications/requests are silently ignored, per the
+		// LSP spec's guidance for methods a server doesn't implement.
+		if msg.ID != nil {
+			return s.t.reply(msg.ID, nil)
+		}
+		return nil
+	}
+}
+
+func (s *Server) publish(uri string) error {
+	content, ok := s.documents[uri]
+	if !ok {
+		return fmt.Errorf("publish: %s is not open", uri)
+	}
+	diagnostics, err := diagnose(uri, content)
+	if err != nil {
+		return err
+	}
+	return s.t.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+// initializeResult advertises the capabilities this server actually
+// implements, so a client doesn't call into something unsupported.
+func initializeResult() map[string]interface{} {
+	return map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"textDocumentSync":   1, // Full
+			"codeActionProvider": true,
+			"executeCommandProvider": map[string]interface{}{
+				"commands": []string{SuppressLineCommand},
+			},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// rpcMessage is the envelope every LSP request, response, and
+// notification shares.
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// transport reads and writes LSP's Content-Length-framed JSON-RPC
+// messages over stdio (or any io.Reader/io.Writer pair, for tests).
+type transport struct {
+	r      *bufio.Reader
+	w      io.Writer
+	nextID int // next id this server assigns to a request it sends
+}
+
+func newTransport(r io.Reader, w io.Writer) *transport {
+	return &transport{r: bufio.NewReader(r), w: w}
+}
+
+// readMessage blocks for the next framed message.
+func (t *transport) readMessage() (*rpcMessage, error) {
+	var contentLength int
+	for {
+		line, err := t.r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break // blank line ends the header block
+		}
+		if strings.HasPrefix(line, "Content-Length:") {
+			n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+			if err != nil {
+				return nil, fmt.Errorf("lsp: bad Content-Length header %q: %w", line, err)
+			}
+			contentLength = n
+		}
+	}
+	if contentLength == 0 {
+		return nil, fmt.Errorf("lsp: message had no Content-Length header")
+	}
+
+	body := make([]byte, contentLength)
+	if _, err := io.ReadFull(t.r, body); err != nil {
+		return nil, err
+	}
+	var msg rpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("lsp: decoding message: %w", err)
+	}
+	return &msg, nil
+}
+
+func (t *transport) writeMessage(msg rpcMessage) error {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(t.w, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
+
+func (t *transport) notify(method string, params interface{}) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return t.writeMessage(rpcMessage{Method: method, Params: raw})
+}
+
+// request sends a server-to-client request (e.g. workspace/applyEdit,
+// which the LSP spec defines as a request, not a notification, since
+// the client replies with whether it applied the edit). This server
+// doesn't wait on or correlate the reply beyond handle's generic
+// "empty Method means it's a response" check, so the id only needs to
+// be present and unique, never reused.
+func (t *transport) request(method string, params interface{}) error {
+	t.nextID++
+	id, err := json.Marshal(t.nextID)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return t.writeMessage(rpcMessage{ID: id, Method: method, Params: raw})
+}
+
+func (t *transport) reply(id json.RawMessage, result interface{}) error {
+	return t.writeMessage(rpcMessage{ID: id, Result: result})
+}
@@ -0,0 +1,193 @@
+// Command skillguard scans Go source for risky patterns: shell
+// execution, unsafe pointer use, outbound network calls, and
+// environment variable reads, plus (via the taint package) data flows
+// from untrusted input into dangerous sinks.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"skillguard/sarif"
+	"skillguard/scan"
+	"skillguard/taint"
+)
+
+// version is reported in SARIF output and future --version flags.
+const version = "0.1.0"
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "vulndeps":
+			runVulndeps(os.Args[2:])
+			return
+		case "fix":
+			runFix(os.Args[2:])
+			return
+		case "lsp":
+			runLSP(os.Args[2:])
+			return
+		}
+	}
+	runScan(os.Args[1:])
+}
+
+// runScan implements the default `skillguard <file.go>` invocation:
+// the AST pattern detectors plus the taint engine over a single file.
+func runScan(args []string) {
+	fs := flag.NewFlagSet("skillguard", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: skillguard [flags] <file.go>\n")
+		fs.PrintDefaults()
+	}
+	noTaint := fs.Bool("no-taint", false, "skip the taint analysis pass")
+	format := fs.String("format", "text", "output format: text or sarif")
+	configPath := fs.String("config", "skillguard.yml", "path to the ruleset config file")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	target := fs.Arg(0)
+
+	cfg, err := LoadConfig(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard: loading %s: %v\n", *configPath, err)
+		os.Exit(1)
+	}
+
+	findings, err := scanFile(target, *noTaint)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skillguard: %v\n", err)
+		os.Exit(1)
+	}
+	findings = cfg.Apply(findings)
+
+	report(findings, *format)
+}
+
+// report sorts and prints findings in the requested format, then
+// exits 1 if any were found — the convention the default scan and the
+// vulndeps subcommand share so CI can gate on exit status alone.
+func report(findings []Finding, format string) {
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Line < findings[j].Line })
+
+	switch format {
+	case "sarif":
+		if err := writeSARIF(os.Stdout, findings); err != nil {
+			fmt.Fprintf(os.Stderr, "skillguard: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		for _, f := range findings {
+			fmt.Println(f)
+		}
+	}
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+// writeSARIF renders findings as a SARIF 2.1.0 log.
+func writeSARIF(w interface{ Write([]byte) (int, error) }, findings []Finding) error {
+	rules := make([]sarif.Rule, 0, len(ruleCatalogue))
+	for _, r := range ruleCatalogue {
+		rules = append(rules, sarif.Rule{
+			ID:               r.ID,
+			ShortDescription: r.ShortDescription,
+			FullDescription:  r.FullDescription,
+			HelpURI:          r.HelpURI,
+		})
+	}
+	sarifFindings := make([]sarif.Finding, 0, len(findings))
+	for _, f := range findings {
+		sarifFindings = append(sarifFindings, sarif.Finding{
+			RuleID:    f.RuleID,
+			Severity:  string(f.Severity),
+			Message:   f.Message,
+			File:      f.File,
+			Line:      f.Line,
+			Column:    f.Column,
+			EndLine:   f.EndLine,
+			EndColumn: f.EndColumn,
+		})
+	}
+	doc, err := sarif.Document(version, rules, sarifFindings)
+	if err != nil {
+		return fmt.Errorf("building SARIF document: %w", err)
+	}
+	_, err = w.Write(append(doc, '\n'))
+	return err
+}
+
+// scanFile runs every detection pass over a single Go source file and
+// returns their combined findings.
+func scanFile(filename string, noTaint bool) ([]Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	findings := scan.ScanFile(fset, file, filename)
+
+	if !noTaint {
+		taintFindings, err := taint.Analyze(filename)
+		if err != nil {
+			return nil, fmt.Errorf("taint analysis of %s: %w", filename, err)
+		}
+		for _, tf := range taintFindings {
+			findings = append(findings, Finding{
+				RuleID:   tf.RuleID,
+				Severity: Severity(tf.Severity),
+				Message:  tf.Message,
+				File:     normalizeTaintFile(filename, tf.File),
+				Line:     tf.Line,
+				Column:   tf.Column,
+			})
+		}
+	}
+
+	// Suppress over the merged finding set so a //skillguard:ignore
+	// comment can silence a taint-engine finding, not just the AST
+	// detectors that ran first.
+	findings = filterSuppressed(findings, suppressedLines(fset, file))
+
+	return findings, nil
+}
+
+// normalizeTaintFile rewrites a taint finding's file path back to the
+// literal path the caller passed for the scanned file, whenever the
+// two refer to the same file: the taint engine loads filename through
+// go/packages, which reports positions against the absolute path it
+// resolved, while the AST detectors in scan.ScanFile just echo back
+// whatever was passed on the CLI. Left alone, the same file shows up
+// under two different artifactLocation.uri values in one SARIF
+// report, defeating code-scanning dedup. tfFile is passed through
+// unchanged when it's some other file in the package (e.g. a taint
+// flow that starts in an imported file), since that genuinely isn't
+// the scanned file.
+func normalizeTaintFile(filename, tfFile string) string {
+	if tfFile == filename {
+		return tfFile
+	}
+	absFilename, err := filepath.Abs(filename)
+	if err != nil {
+		return tfFile
+	}
+	absTfFile, err := filepath.Abs(tfFile)
+	if err != nil {
+		return tfFile
+	}
+	if absFilename == absTfFile {
+		return filename
+	}
+	return tfFile
+}
@@ -0,0 +1,45 @@
+package main
+
+// ruleInfo describes one entry in SkillGuard's rule catalogue, shared
+// between the text reporter and the SARIF writer.
+type ruleInfo struct {
+	ID               string
+	ShortDescription string
+	FullDescription  string
+	HelpURI          string
+	DefaultSeverity  Severity
+}
+
+// ruleCatalogue lists every rule ID SkillGuard can emit, across the
+// AST pattern detectors and the taint engine. New detector packages
+// register here so `--format sarif` can describe them without the
+// caller having run them yet.
+var ruleCatalogue = []ruleInfo{
+	{ID: "SG-EXEC-001", ShortDescription: "Shell execution", FullDescription: "Call to exec.Command or exec.CommandContext.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-EXEC-001.md", DefaultSeverity: SeverityCritical},
+	{ID: "SG-FILE-001", ShortDescription: "Destructive file operation", FullDescription: "Call to os.WriteFile, os.Remove, or os.RemoveAll.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-FILE-001.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-UNSAFE-001", ShortDescription: "Unsafe pointer operation", FullDescription: "Use of the unsafe package.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-UNSAFE-001.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-NET-EXFIL-001", ShortDescription: "Outbound network call", FullDescription: "Call to http.Get, http.Post, or http.PostForm.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-NET-EXFIL-001.md", DefaultSeverity: SeverityMedium},
+	{ID: "SG-ENV-001", ShortDescription: "Environment variable access", FullDescription: "Call to os.Getenv or os.LookupEnv.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-ENV-001.md", DefaultSeverity: SeverityLow},
+	{ID: "SG-TAINT-EXEC", ShortDescription: "Tainted command execution", FullDescription: "Attacker-controlled data reaches exec.Command, exec.CommandContext, syscall.Exec, or os.StartProcess.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-TAINT-EXEC.md", DefaultSeverity: SeverityCritical},
+	{ID: "SG-TAINT-SQL", ShortDescription: "Tainted SQL query", FullDescription: "Attacker-controlled data reaches db.Query or db.Exec without parameterisation.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-TAINT-SQL.md", DefaultSeverity: SeverityCritical},
+	{ID: "SG-TAINT-PATH", ShortDescription: "Tainted file path", FullDescription: "Attacker-controlled data reaches os.Open or os.ReadFile, risking path traversal.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-TAINT-PATH.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-TAINT-REDIRECT", ShortDescription: "Tainted redirect target", FullDescription: "Attacker-controlled data reaches http.Redirect.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-TAINT-REDIRECT.md", DefaultSeverity: SeverityMedium},
+	{ID: "SG-VULNDEP-001", ShortDescription: "Vulnerable dependency", FullDescription: "A go.mod dependency matches a known OSV.dev advisory.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-VULNDEP-001.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-G102", ShortDescription: "Bind to all interfaces", FullDescription: "A listener is bound to 0.0.0.0 or a bare port instead of a specific address.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-G102.md", DefaultSeverity: SeverityMedium},
+	{ID: "SG-G101", ShortDescription: "Hardcoded credentials", FullDescription: "A high-entropy string literal is assigned to an identifier named like a credential.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-G101.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-G201", ShortDescription: "SQL query built at runtime", FullDescription: "A *sql.DB query method is called with a concatenated or fmt.Sprintf-built statement instead of a parameterised placeholder.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-G201.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-G304", ShortDescription: "Unvalidated file path", FullDescription: "A file read uses a path that is neither a literal nor clamped to a base directory with a filepath.Clean + strings.HasPrefix guard.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-G304.md", DefaultSeverity: SeverityHigh},
+	{ID: "SG-MUX-CONNECT-001", ShortDescription: "CONNECT path-normalisation bypass", FullDescription: "A prefix route on an http.ServeMux may be reachable via CONNECT before path normalisation.", HelpURI: "https://github.com/gauravsingh1995/skillgaurd/blob/main/docs/rules/SG-MUX-CONNECT-001.md", DefaultSeverity: SeverityMedium},
+}
+
+// ruleByID looks up a catalogue entry, returning ok=false for rule
+// IDs not yet registered (e.g. from a detector package added after
+// this catalogue was last updated).
+func ruleByID(id string) (ruleInfo, bool) {
+	for _, r := range ruleCatalogue {
+		if r.ID == id {
+			return r, true
+		}
+	}
+	return ruleInfo{}, false
+}
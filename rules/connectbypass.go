@@ -0,0 +1,81 @@
+package rules
+
+import "go/ast"
+
+func init() {
+	Register(connectBypassRule{})
+}
+
+// connectBypassRule flags prefix routes registered on an
+// *http.ServeMux. ServeMux's CONNECT handling doesn't run the usual
+// path-cleaning step before matching, so a prefix pattern (ending in
+// "/") can be reached with a `..`-laden request-URI that a literal
+// pattern would have rejected. This is a syntactic approximation:
+// it can't see whether the handler itself re-validates the path, so
+// it flags every prefix registration for review.
+type connectBypassRule struct{}
+
+func (connectBypassRule) ID() string { return "SG-MUX-CONNECT-001" }
+
+var muxRegisterMethods = map[string]bool{
+	"Handle":     true,
+	"HandleFunc": true,
+}
+
+func (r connectBypassRule) Check(pass *Pass) []Finding {
+	var findings []Finding
+	ast.Inspect(pass.File, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !muxRegisterMethods[sel.Sel.Name] || len(call.Args) == 0 {
+			return true
+		}
+		if !looksLikeServeMux(sel.X) {
+			return true
+		}
+		pattern, ok := addrLiteral(call.Args[0])
+		if !ok || !isPrefixPattern(pattern) {
+			return true
+		}
+		pos := pass.Fset.Position(call.Pos())
+		end := pass.Fset.Position(call.End())
+		findings = append(findings, Finding{
+			RuleID:    r.ID(),
+			Severity:  SeverityMedium,
+			Message:   "prefix route \"" + pattern + "\" on an http.ServeMux may be reachable via CONNECT before path normalisation",
+			File:      pass.Filename,
+			Line:      pos.Line,
+			Column:    pos.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		})
+		return true
+	})
+	return findings
+}
+
+// looksLikeServeMux is a name-based heuristic: without type
+// information this package can't resolve the receiver's type, so it
+// matches common receiver names (mux, router, serveMux, ...) instead.
+// "http" is included too: http.Handle/http.HandleFunc register
+// directly on http.DefaultServeMux, which is exactly the same
+// CONNECT-before-normalisation exposure as an explicit *ServeMux.
+func looksLikeServeMux(recv ast.Expr) bool {
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	switch ident.Name {
+	case "mux", "Mux", "router", "serveMux", "ServeMux", "http":
+		return true
+	default:
+		return false
+	}
+}
+
+func isPrefixPattern(pattern string) bool {
+	return len(pattern) > 0 && pattern[len(pattern)-1] == '/'
+}
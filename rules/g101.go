@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"go/ast"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+func init() {
+	Register(hardcodedCredentialsRule{})
+}
+
+// hardcodedCredentialsRule is gosec's G101: flag string literals
+// assigned to an identifier whose name suggests a credential, gated
+// on Shannon entropy so dictionary-word placeholders like
+// `password := "changeme"` don't drown out real secrets.
+type hardcodedCredentialsRule struct{}
+
+func (hardcodedCredentialsRule) ID() string { return "SG-G101" }
+
+var credentialNameRe = regexp.MustCompile(`(?i)pass|secret|token|api[_-]?key`)
+
+const minCredentialEntropy = 3.5 // bits/char
+
+func (r hardcodedCredentialsRule) Check(pass *Pass) []Finding {
+	var findings []Finding
+
+	check := func(name string, lit *ast.BasicLit) {
+		if !credentialNameRe.MatchString(name) {
+			return
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil || shannonEntropy(value) < minCredentialEntropy {
+			return
+		}
+		pos := pass.Fset.Position(lit.Pos())
+		end := pass.Fset.Position(lit.End())
+		findings = append(findings, Finding{
+			RuleID:    r.ID(),
+			Severity:  SeverityHigh,
+			Message:   "hardcoded credential assigned to " + name,
+			File:      pass.Filename,
+			Line:      pos.Line,
+			Column:    pos.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		})
+	}
+
+	ast.Inspect(pass.File, func(n ast.Node) bool {
+		switch decl := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range decl.Lhs {
+				if i >= len(decl.Rhs) {
+					break
+				}
+				ident, ok := lhs.(*ast.Ident)
+				lit, litOK := decl.Rhs[i].(*ast.BasicLit)
+				if ok && litOK && lit.Kind.String() == "STRING" {
+					check(ident.Name, lit)
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range decl.Names {
+				if i >= len(decl.Values) {
+					break
+				}
+				if lit, ok := decl.Values[i].(*ast.BasicLit); ok && lit.Kind.String() == "STRING" {
+					check(name.Name, lit)
+				}
+			}
+		}
+		return true
+	})
+
+	return findings
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per
+// character.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,183 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Register(bindAllInterfacesRule{})
+}
+
+// bindAllInterfacesRule is gosec's G102: flag a listener bound to
+// every network interface instead of a specific address.
+type bindAllInterfacesRule struct{}
+
+func (bindAllInterfacesRule) ID() string { return "SG-G102" }
+
+// bindCalls covers the calls whose address argument is a plain string
+// literal; net.ListenTCP takes a *net.TCPAddr instead, so Check
+// resolves it separately via tcpAddrBindsAllInterfaces.
+var bindCalls = map[string]int{ // qualified call -> index of the address argument
+	"net.Listen":          1,
+	"http.ListenAndServe": 0,
+}
+
+func (r bindAllInterfacesRule) Check(pass *Pass) []Finding {
+	var findings []Finding
+	ast.Inspect(pass.File, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		qualified := pkgIdent.Name + "." + sel.Sel.Name
+
+		var desc string
+		switch {
+		case qualified == "net.ListenTCP":
+			if len(call.Args) < 2 {
+				return true
+			}
+			d, bindsAll := tcpAddrBindsAllInterfaces(call.Args[1])
+			if !bindsAll {
+				return true
+			}
+			desc = "net.ListenTCP(..., " + d + ")"
+		default:
+			argIndex, known := bindCalls[qualified]
+			if !known || argIndex >= len(call.Args) {
+				return true
+			}
+			addr, ok := addrLiteral(call.Args[argIndex])
+			if !ok || !bindsAllInterfaces(addr) {
+				return true
+			}
+			desc = qualified + `("` + addr + `")`
+		}
+
+		pos := pass.Fset.Position(call.Pos())
+		end := pass.Fset.Position(call.End())
+		findings = append(findings, Finding{
+			RuleID:    r.ID(),
+			Severity:  SeverityMedium,
+			Message:   "binds to all interfaces via " + desc,
+			File:      pass.Filename,
+			Line:      pos.Line,
+			Column:    pos.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		})
+		return true
+	})
+	return findings
+}
+
+// tcpAddrBindsAllInterfaces resolves a net.ListenTCP laddr argument
+// and reports whether it binds every interface: either a nil laddr
+// (the OS picks the address, which is the unspecified "all
+// interfaces" address), or a &net.TCPAddr{...} literal whose IP field
+// is absent (the zero value, also "all interfaces") or explicitly set
+// to the unspecified/zero address. Anything else — a resolvable
+// non-zero address, or an IP expression this rule can't evaluate
+// statically — is left alone rather than guessed at.
+func tcpAddrBindsAllInterfaces(expr ast.Expr) (desc string, bindsAll bool) {
+	if ident, ok := expr.(*ast.Ident); ok && ident.Name == "nil" {
+		return "nil", true
+	}
+	unary, ok := expr.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return "", false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return "", false
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "TCPAddr" {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "net" {
+		return "", false
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || key.Name != "IP" {
+			continue
+		}
+		addr, resolved := unspecifiedIPExpr(kv.Value)
+		if !resolved {
+			return "", false // an IP this rule can't evaluate; don't guess
+		}
+		return addr, bindsAllInterfaces(addr)
+	}
+	// No IP field set at all: *net.TCPAddr's zero-value IP is nil,
+	// which net.ListenTCP treats as "all interfaces".
+	return "unset IP", true
+}
+
+// unspecifiedIPExpr recognizes the handful of ways source code spells
+// the IPv4/IPv6 unspecified address, returning a bindsAllInterfaces-
+// compatible string form.
+func unspecifiedIPExpr(expr ast.Expr) (addr string, resolved bool) {
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", false
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || pkg.Name != "net" || sel.Sel.Name != "ParseIP" || len(e.Args) != 1 {
+			return "", false
+		}
+		return addrLiteral(e.Args[0])
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok || pkg.Name != "net" {
+			return "", false
+		}
+		switch e.Sel.Name {
+		case "IPv4zero", "IPv6zero", "IPv6unspecified":
+			return "0.0.0.0", true
+		}
+	}
+	return "", false
+}
+
+func addrLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+func bindsAllInterfaces(addr string) bool {
+	if addr == "" {
+		return true
+	}
+	if strings.HasPrefix(addr, "0.0.0.0") {
+		return true
+	}
+	// A bare ":port" form (no host before the colon) also binds every
+	// interface.
+	return strings.HasPrefix(addr, ":")
+}
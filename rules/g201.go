@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"go/ast"
+	"strings"
+)
+
+func init() {
+	Register(sqlStringFormatRule{})
+}
+
+// sqlStringFormatRule covers gosec's G201 (SQL query built with
+// format string) and G202 (SQL query built with string
+// concatenation): both flag a *sql.DB query method whose statement
+// argument is a `+` concatenation or a fmt.Sprintf call, since
+// building it at runtime risks SQL injection even when the surrounding
+// code never touches untrusted input. Any other non-literal statement
+// (e.g. a plain variable) is not flagged.
+type sqlStringFormatRule struct{}
+
+func (sqlStringFormatRule) ID() string { return "SG-G201" }
+
+var sqlQueryMethods = map[string]bool{
+	"Query": true, "QueryContext": true,
+	"Exec": true, "ExecContext": true,
+	"QueryRow": true, "QueryRowContext": true,
+}
+
+func (r sqlStringFormatRule) Check(pass *Pass) []Finding {
+	var findings []Finding
+	ast.Inspect(pass.File, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !sqlQueryMethods[sel.Sel.Name] || len(call.Args) == 0 {
+			return true
+		}
+		stmt := call.Args[0]
+		if strings.HasSuffix(sel.Sel.Name, "Context") {
+			// The *Context variants take a context.Context first.
+			if len(call.Args) < 2 {
+				return true
+			}
+			stmt = call.Args[1]
+		}
+		reason, risky := riskyQueryBuild(stmt)
+		if !risky {
+			return true
+		}
+		pos := pass.Fset.Position(call.Pos())
+		end := pass.Fset.Position(call.End())
+		findings = append(findings, Finding{
+			RuleID:    r.ID(),
+			Severity:  SeverityHigh,
+			Message:   sel.Sel.Name + " builds its statement via " + reason + " instead of a parameterised placeholder",
+			File:      pass.Filename,
+			Line:      pos.Line,
+			Column:    pos.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		})
+		return true
+	})
+	return findings
+}
+
+// riskyQueryBuild reports whether a query statement expression was
+// assembled at runtime rather than written as a literal.
+func riskyQueryBuild(stmt ast.Expr) (reason string, risky bool) {
+	switch e := stmt.(type) {
+	case *ast.BinaryExpr:
+		if e.Op.String() == "+" {
+			return "string concatenation", true
+		}
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "fmt" && sel.Sel.Name == "Sprintf" {
+				return "fmt.Sprintf", true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,176 @@
+package rules
+
+import "go/ast"
+
+func init() {
+	Register(pathTraversalRule{})
+}
+
+// pathTraversalRule is gosec's G304: flag a file read whose path
+// isn't a literal and isn't clamped to a base directory first. This
+// is a syntactic heuristic rather than the dataflow-precise version
+// in the taint engine (see skillguard/taint's SG-TAINT-PATH): it
+// can't prove the guard actually covers the value reaching the read,
+// so it only recognises one shape as safe — a filepath.Clean result
+// that some earlier `if !strings.HasPrefix(result, base) { ... }` in
+// the same function has already rejected when it escapes the base
+// directory. filepath.Clean alone is NOT safe: Clean normalises
+// "../../etc/passwd" but doesn't change where it points, so a bare
+// Clean wrap still leaves the traversal in place.
+type pathTraversalRule struct{}
+
+func (pathTraversalRule) ID() string { return "SG-G304" }
+
+var fileReadCalls = map[string]bool{
+	"os.Open":         true,
+	"os.ReadFile":     true,
+	"ioutil.ReadFile": true,
+}
+
+func (r pathTraversalRule) Check(pass *Pass) []Finding {
+	var findings []Finding
+	ast.Inspect(pass.File, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			body = fn.Body
+		case *ast.FuncLit:
+			body = fn.Body
+		default:
+			return true
+		}
+		if body == nil {
+			return true
+		}
+		findings = append(findings, r.checkBody(pass, body)...)
+		return true
+	})
+	return findings
+}
+
+// checkBody walks a single function body, tracking which variable
+// names were assigned a filepath.Clean result and then passed a
+// "HasPrefix(v, base)" base-dir guard before the point a read call is
+// reached, then flags reads whose path argument isn't a literal or one
+// of those guarded variables.
+func (r pathTraversalRule) checkBody(pass *Pass, body *ast.BlockStmt) []Finding {
+	var findings []Finding
+	guarded := map[string]bool{}
+	cleaned := map[string]bool{} // names assigned a filepath.Clean(...) result
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range stmt.Lhs {
+				ident, isIdent := lhs.(*ast.Ident)
+				if !isIdent || i >= len(stmt.Rhs) {
+					continue
+				}
+				if call, isCall := stmt.Rhs[i].(*ast.CallExpr); isCall {
+					if sel, isSel := call.Fun.(*ast.SelectorExpr); isSel && sel.Sel.Name == "Clean" {
+						if pkg, isPkg := sel.X.(*ast.Ident); isPkg && pkg.Name == "filepath" {
+							cleaned[ident.Name] = true
+						}
+					}
+				}
+			}
+		case *ast.IfStmt:
+			if name, ok := baseDirGuardTarget(stmt); ok && cleaned[name] {
+				guarded[name] = true
+			}
+		case *ast.CallExpr:
+			sel, ok := stmt.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkgIdent, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			qualified := pkgIdent.Name + "." + sel.Sel.Name
+			if !fileReadCalls[qualified] || len(stmt.Args) == 0 {
+				return true
+			}
+			if isSafePathArg(stmt.Args[0], guarded) {
+				return true
+			}
+			pos := pass.Fset.Position(stmt.Pos())
+			end := pass.Fset.Position(stmt.End())
+			findings = append(findings, Finding{
+				RuleID:    r.ID(),
+				Severity:  SeverityHigh,
+				Message:   qualified + " reads a path that is neither a literal nor clamped to a base directory",
+				File:      pass.Filename,
+				Line:      pos.Line,
+				Column:    pos.Column,
+				EndLine:   end.Line,
+				EndColumn: end.Column,
+			})
+		}
+		return true
+	})
+	return findings
+}
+
+// baseDirGuardTarget reports whether ifStmt is an early-exit guard of
+// the shape `if !strings.HasPrefix(v, base) { return/continue/break/panic }`
+// and, if so, the name of the guarded variable v.
+func baseDirGuardTarget(ifStmt *ast.IfStmt) (string, bool) {
+	not, ok := ifStmt.Cond.(*ast.UnaryExpr)
+	if !ok || not.Op.String() != "!" {
+		return "", false
+	}
+	call, ok := not.X.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.Name != "strings" || sel.Sel.Name != "HasPrefix" {
+		return "", false
+	}
+	ident, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	if !exitsBlock(ifStmt.Body) {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// exitsBlock reports whether a block ends in a statement that stops
+// control flow from reaching past it, i.e. the guard it belongs to
+// really does reject the value rather than merely logging it.
+func exitsBlock(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch last := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		return ok && ident.Name == "panic"
+	default:
+		return false
+	}
+}
+
+func isSafePathArg(arg ast.Expr, guarded map[string]bool) bool {
+	switch e := arg.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		return guarded[e.Name]
+	default:
+		return false
+	}
+}
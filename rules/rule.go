@@ -0,0 +1,75 @@
+// Package rules holds SkillGuard's AST-level detectors that aren't
+// part of the taint engine: one file per rule, each registering
+// itself into a shared catalogue so the runner (scan.ScanFile in
+// skillguard/scan) never needs to change when a new rule is added.
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Severity mirrors the top-level package's severity scale.
+type Severity string
+
+const (
+	SeverityLow      Severity = "LOW"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityHigh     Severity = "HIGH"
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// Finding is a single issue reported by a Rule.
+type Finding struct {
+	RuleID   string
+	Severity Severity
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	// EndLine and EndColumn close out the finding's span when the
+	// rule knows it; zero means "unknown".
+	EndLine   int
+	EndColumn int
+}
+
+// Pass is the input a Rule runs against: one parsed file and the
+// FileSet needed to turn its positions into line/column numbers.
+type Pass struct {
+	Fset     *token.FileSet
+	File     *ast.File
+	Filename string
+}
+
+// Rule is a single detector. Implementations are typically a handful
+// of lines that call ast.Inspect over pass.File and return any
+// matches.
+type Rule interface {
+	ID() string
+	Check(pass *Pass) []Finding
+}
+
+// registry holds every Rule registered via Register, in registration
+// order.
+var registry []Rule
+
+// Register adds a Rule to the catalogue. Rule implementations call
+// this from an init() in their own file.
+func Register(r Rule) {
+	registry = append(registry, r)
+}
+
+// All returns every registered Rule.
+func All() []Rule {
+	return registry
+}
+
+// RunAll runs every registered Rule against pass and returns their
+// combined findings.
+func RunAll(pass *Pass) []Finding {
+	var findings []Finding
+	for _, r := range registry {
+		findings = append(findings, r.Check(pass)...)
+	}
+	return findings
+}
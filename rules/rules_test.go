@@ -0,0 +1,219 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+// ruleIDs returns the RuleIDs of every finding Check produces for src.
+func ruleIDs(t *testing.T, rule Rule, src string) []string {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	pass := &Pass{Fset: fset, File: file, Filename: "test.go"}
+	var ids []string
+	for _, f := range rule.Check(pass) {
+		ids = append(ids, f.RuleID)
+	}
+	return ids
+}
+
+func TestRulesTableDriven(t *testing.T) {
+	cases := []struct {
+		name    string
+		rule    Rule
+		src     string
+		wantHit bool
+	}{
+		{
+			name: "G101 flags high-entropy credential literal",
+			rule: hardcodedCredentialsRule{},
+			src: `package p
+func f() {
+	apiKey := "x7Qp$9zR!mK2vL"
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G101 ignores low-entropy placeholder",
+			rule: hardcodedCredentialsRule{},
+			src: `package p
+func f() {
+	password := "changeme"
+}`,
+			wantHit: false,
+		},
+		{
+			name: "G102 flags net.Listen on 0.0.0.0",
+			rule: bindAllInterfacesRule{},
+			src: `package p
+import "net"
+func f() {
+	net.Listen("tcp", "0.0.0.0:8080")
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G102 flags net.ListenTCP with nil laddr",
+			rule: bindAllInterfacesRule{},
+			src: `package p
+import "net"
+func f() {
+	net.ListenTCP("tcp", nil)
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G102 flags net.ListenTCP with unset IP field",
+			rule: bindAllInterfacesRule{},
+			src: `package p
+import "net"
+func f() {
+	net.ListenTCP("tcp", &net.TCPAddr{Port: 8080})
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G102 ignores net.ListenTCP bound to a specific IP",
+			rule: bindAllInterfacesRule{},
+			src: `package p
+import "net"
+func f() {
+	net.ListenTCP("tcp", &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 8080})
+}`,
+			wantHit: false,
+		},
+		{
+			name: "G102 ignores net.Listen bound to a specific host",
+			rule: bindAllInterfacesRule{},
+			src: `package p
+import "net"
+func f() {
+	net.Listen("tcp", "127.0.0.1:8080")
+}`,
+			wantHit: false,
+		},
+		{
+			name: "G201 flags fmt.Sprintf query",
+			rule: sqlStringFormatRule{},
+			src: `package p
+import "fmt"
+func f(db *sql.DB, id string) {
+	db.Query(fmt.Sprintf("SELECT * FROM t WHERE id = %s", id))
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G201 ignores literal query",
+			rule: sqlStringFormatRule{},
+			src: `package p
+func f(db *sql.DB) {
+	db.Query("SELECT * FROM t")
+}`,
+			wantHit: false,
+		},
+		{
+			name: "G304 flags unguarded path",
+			rule: pathTraversalRule{},
+			src: `package p
+import "os"
+func f(name string) {
+	os.Open(name)
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G304 flags bare filepath.Clean with no base-dir guard",
+			rule: pathTraversalRule{},
+			src: `package p
+import (
+	"os"
+	"path/filepath"
+)
+func f(name string) {
+	os.Open(filepath.Clean(name))
+}`,
+			wantHit: true,
+		},
+		{
+			name: "G304 ignores filepath.Clean guarded by a HasPrefix base-dir check",
+			rule: pathTraversalRule{},
+			src: `package p
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+func f(base, name string) {
+	cleaned := filepath.Clean(name)
+	if !strings.HasPrefix(cleaned, base) {
+		return
+	}
+	os.Open(cleaned)
+}`,
+			wantHit: false,
+		},
+		{
+			name: "MUX-CONNECT flags prefix route on a mux variable",
+			rule: connectBypassRule{},
+			src: `package p
+func f(mux *http.ServeMux, h http.Handler) {
+	mux.Handle("/api/", h)
+}`,
+			wantHit: true,
+		},
+		{
+			name: "MUX-CONNECT flags prefix route on http.DefaultServeMux",
+			rule: connectBypassRule{},
+			src: `package p
+import "net/http"
+func f(h http.Handler) {
+	http.Handle("/api/", h)
+}`,
+			wantHit: true,
+		},
+		{
+			name: "MUX-CONNECT ignores a literal (non-prefix) route",
+			rule: connectBypassRule{},
+			src: `package p
+import "net/http"
+func f(h http.Handler) {
+	http.Handle("/api/widgets", h)
+}`,
+			wantHit: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids := ruleIDs(t, c.rule, c.src)
+			hit := len(ids) > 0
+			if hit != c.wantHit {
+				t.Errorf("got findings %v, wantHit=%v", ids, c.wantHit)
+			}
+		})
+	}
+}
+
+func TestAllRegistersEveryRule(t *testing.T) {
+	want := map[string]bool{
+		"SG-G101":            true,
+		"SG-G102":            true,
+		"SG-G201":            true,
+		"SG-G304":            true,
+		"SG-MUX-CONNECT-001": true,
+	}
+	got := map[string]bool{}
+	for _, r := range All() {
+		got[r.ID()] = true
+	}
+	for id := range want {
+		if !got[id] {
+			t.Errorf("All() is missing rule %s", id)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Package sarif builds SARIF 2.1.0 documents from SkillGuard
+// findings, for consumption by GitHub code scanning, GitLab, and
+// other SARIF-aware dashboards.
+package sarif
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+const schemaURI = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+// Rule is one entry in a SARIF tool driver's rule list.
+type Rule struct {
+	ID               string
+	ShortDescription string
+	FullDescription  string
+	HelpURI          string
+}
+
+// Finding is the subset of a SkillGuard finding the SARIF writer
+// needs; callers map their own Finding type into this one so this
+// package has no dependency back on the CLI.
+type Finding struct {
+	RuleID    string
+	Severity  string // CRITICAL, HIGH, MEDIUM, or LOW
+	Message   string
+	File      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int
+}
+
+// Document builds a complete SARIF 2.1.0 log for one run of
+// SkillGuard, given the rules it knows about and the findings from
+// that run. Rules with no matching finding are still listed, as SARIF
+// consumers use the full rule list to render enable/disable toggles.
+func Document(toolVersion string, rules []Rule, findings []Finding) ([]byte, error) {
+	driver := sarifDriver{
+		Name:           "SkillGuard",
+		InformationURI: "https://github.com/gauravsingh1995/skillgaurd",
+		Version:        toolVersion,
+	}
+	for _, r := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:               r.ID,
+			ShortDescription: sarifMessage{Text: r.ShortDescription},
+			FullDescription:  sarifMessage{Text: r.FullDescription},
+			HelpURI:          r.HelpURI,
+		})
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		endLine, endCol := f.EndLine, f.EndColumn
+		if endLine == 0 {
+			endLine = f.Line
+		}
+		if endCol == 0 {
+			endCol = f.Column
+		}
+		results = append(results, sarifResult{
+			RuleID:  f.RuleID,
+			Level:   levelFor(f.Severity),
+			Message: sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: f.File},
+					Region: sarifRegion{
+						StartLine:   f.Line,
+						StartColumn: f.Column,
+						EndLine:     endLine,
+						EndColumn:   endCol,
+					},
+				},
+			}},
+			PartialFingerprints: map[string]string{
+				"skillguardFingerprint/v1": fingerprint(f),
+			},
+		})
+	}
+
+	doc := sarifLog{
+		Schema:  schemaURI,
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: driver},
+			Results: results,
+		}},
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// levelFor maps a SkillGuard severity onto the SARIF result levels
+// (error/warning/note); LOW findings are informational notes rather
+// than warnings so they don't fail a naive "no warnings" CI gate.
+func levelFor(severity string) string {
+	switch severity {
+	case "CRITICAL", "HIGH":
+		return "error"
+	case "MEDIUM":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// fingerprint derives a stable identifier for a finding from its rule,
+// file, line and column, so the same issue at the same position
+// dedupes across runs. A finding whose line or column moves gets a new
+// fingerprint.
+func fingerprint(f Finding) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%d", f.RuleID, f.File, f.Line, f.Column)))
+	return hex.EncodeToString(sum[:16])
+}
+
+// The following types mirror the subset of the SARIF 2.1.0 object
+// model SkillGuard emits; see the schema referenced by schemaURI for
+// the full specification.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	FullDescription  sarifMessage `json:"fullDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
@@ -0,0 +1,99 @@
+package sarif
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDocumentPopulatesRegionAndFingerprint(t *testing.T) {
+	rules := []Rule{{ID: "SG-G101", ShortDescription: "hardcoded credential"}}
+	findings := []Finding{{
+		RuleID:    "SG-G101",
+		Severity:  "HIGH",
+		Message:   "hardcoded credential assigned to apiKey",
+		File:      "main.go",
+		Line:      10,
+		Column:    5,
+		EndLine:   10,
+		EndColumn: 30,
+	}}
+
+	raw, err := Document("v1.2.3", rules, findings)
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+
+	var doc sarifLog
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if doc.Version != "2.1.0" {
+		t.Errorf("Version = %q, want 2.1.0", doc.Version)
+	}
+	if len(doc.Runs) != 1 {
+		t.Fatalf("got %d runs, want 1", len(doc.Runs))
+	}
+	run := doc.Runs[0]
+	if len(run.Tool.Driver.Rules) != 1 || run.Tool.Driver.Rules[0].ID != "SG-G101" {
+		t.Errorf("driver rules = %+v, want one rule SG-G101", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 1 {
+		t.Fatalf("got %d results, want 1", len(run.Results))
+	}
+	region := run.Results[0].Locations[0].PhysicalLocation.Region
+	if region.StartLine != 10 || region.StartColumn != 5 || region.EndLine != 10 || region.EndColumn != 30 {
+		t.Errorf("region = %+v, want start (10,5) end (10,30)", region)
+	}
+	if run.Results[0].Level != "error" {
+		t.Errorf("Level = %q, want error for HIGH severity", run.Results[0].Level)
+	}
+	fp := run.Results[0].PartialFingerprints["skillguardFingerprint/v1"]
+	if fp == "" {
+		t.Error("missing skillguardFingerprint/v1 partial fingerprint")
+	}
+}
+
+func TestDocumentRegionFallsBackWhenEndUnset(t *testing.T) {
+	findings := []Finding{{RuleID: "SG-G304", Severity: "HIGH", File: "a.go", Line: 4, Column: 2}}
+
+	raw, err := Document("v1.0.0", nil, findings)
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+	var doc sarifLog
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	region := doc.Runs[0].Results[0].Locations[0].PhysicalLocation.Region
+	if region.EndLine != 4 || region.EndColumn != 2 {
+		t.Errorf("region = %+v, want fallback end (4,2) matching start", region)
+	}
+}
+
+func TestLevelFor(t *testing.T) {
+	cases := map[string]string{
+		"CRITICAL": "error",
+		"HIGH":     "error",
+		"MEDIUM":   "warning",
+		"LOW":      "note",
+		"":         "note",
+	}
+	for severity, want := range cases {
+		if got := levelFor(severity); got != want {
+			t.Errorf("levelFor(%q) = %q, want %q", severity, got, want)
+		}
+	}
+}
+
+func TestFingerprintStableAndDistinct(t *testing.T) {
+	a := Finding{RuleID: "SG-G101", File: "main.go", Line: 10, Column: 5}
+	b := Finding{RuleID: "SG-G101", File: "main.go", Line: 10, Column: 5}
+	c := Finding{RuleID: "SG-G101", File: "main.go", Line: 11, Column: 5}
+
+	if fingerprint(a) != fingerprint(b) {
+		t.Error("fingerprint should be stable for identical findings")
+	}
+	if fingerprint(a) == fingerprint(c) {
+		t.Error("fingerprint should differ when the line changes")
+	}
+}
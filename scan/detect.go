@@ -0,0 +1,143 @@
+package scan
+
+import (
+	"go/ast"
+	"go/token"
+
+	"skillguard/rules"
+)
+
+// ScanFile runs SkillGuard's AST-level passes over an already-parsed
+// file: the original hardcoded-signature detectors plus every rule
+// registered in skillguard/rules. It does not run the taint engine,
+// which needs a full package load rather than a single file's AST.
+func ScanFile(fset *token.FileSet, file *ast.File, filename string) []Finding {
+	findings := detectCalls(fset, file, filename)
+
+	pass := &rules.Pass{Fset: fset, File: file, Filename: filename}
+	for _, rf := range rules.RunAll(pass) {
+		findings = append(findings, Finding{
+			RuleID:    rf.RuleID,
+			Severity:  Severity(rf.Severity),
+			Message:   rf.Message,
+			File:      rf.File,
+			Line:      rf.Line,
+			Column:    rf.Column,
+			EndLine:   rf.EndLine,
+			EndColumn: rf.EndColumn,
+		})
+	}
+	return findings
+}
+
+// detectCalls walks a file's AST and flags calls to a fixed list of
+// well-known dangerous functions. This is the original SkillGuard
+// detection strategy: it knows the exact package.Func signatures to
+// look for and has no notion of where the arguments came from, so it
+// only fires when the dangerous call is present at all, regardless of
+// whether its arguments are attacker-controlled.
+func detectCalls(fset *token.FileSet, file *ast.File, filename string) []Finding {
+	var findings []Finding
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		pos := fset.Position(call.Pos())
+		end := fset.Position(call.End())
+		qualified := pkgIdent.Name + "." + sel.Sel.Name
+
+		switch qualified {
+		case "exec.Command", "exec.CommandContext":
+			findings = append(findings, Finding{
+				RuleID:    "SG-EXEC-001",
+				Severity:  SeverityCritical,
+				Message:   "shell execution via " + qualified,
+				File:      filename,
+				Line:      pos.Line,
+				Column:    pos.Column,
+				EndLine:   end.Line,
+				EndColumn: end.Column,
+			})
+		case "os.WriteFile", "os.Remove", "os.RemoveAll":
+			findings = append(findings, Finding{
+				RuleID:    "SG-FILE-001",
+				Severity:  SeverityHigh,
+				Message:   "destructive file operation via " + qualified,
+				File:      filename,
+				Line:      pos.Line,
+				Column:    pos.Column,
+				EndLine:   end.Line,
+				EndColumn: end.Column,
+			})
+		case "http.Get", "http.Post", "http.PostForm":
+			findings = append(findings, Finding{
+				RuleID:    "SG-NET-EXFIL-001",
+				Severity:  SeverityMedium,
+				Message:   "outbound network call via " + qualified,
+				File:      filename,
+				Line:      pos.Line,
+				Column:    pos.Column,
+				EndLine:   end.Line,
+				EndColumn: end.Column,
+			})
+		case "os.Getenv", "os.LookupEnv":
+			findings = append(findings, Finding{
+				RuleID:    "SG-ENV-001",
+				Severity:  SeverityLow,
+				Message:   "environment variable access via " + qualified,
+				File:      filename,
+				Line:      pos.Line,
+				Column:    pos.Column,
+				EndLine:   end.Line,
+				EndColumn: end.Column,
+			})
+		}
+		return true
+	})
+
+	findings = append(findings, detectUnsafe(fset, file, filename)...)
+	return findings
+}
+
+// detectUnsafe flags any use of the unsafe package, which SkillGuard
+// always treats as HIGH severity regardless of context.
+func detectUnsafe(fset *token.FileSet, file *ast.File, filename string) []Finding {
+	var findings []Finding
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok || pkgIdent.Name != "unsafe" {
+			return true
+		}
+		pos := fset.Position(sel.Pos())
+		end := fset.Position(sel.End())
+		findings = append(findings, Finding{
+			RuleID:    "SG-UNSAFE-001",
+			Severity:  SeverityHigh,
+			Message:   "unsafe operation via unsafe." + sel.Sel.Name,
+			File:      filename,
+			Line:      pos.Line,
+			Column:    pos.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		})
+		return true
+	})
+
+	return findings
+}
@@ -0,0 +1,38 @@
+// Package scan holds the AST-level pattern detectors shared by every
+// SkillGuard entry point (the CLI, and the LSP server in
+// skillguard/lsp), plus the Finding type they report through.
+package scan
+
+import "fmt"
+
+// Severity is the risk level assigned to a Finding.
+type Severity string
+
+// Severity levels, ordered from least to most urgent.
+const (
+	SeverityLow      Severity = "LOW"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityHigh     Severity = "HIGH"
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// Finding is a single issue reported by a detector.
+type Finding struct {
+	RuleID   string
+	Severity Severity
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	// EndLine and EndColumn close out the finding's span when a
+	// detector knows it (e.g. the end of the flagged call
+	// expression); zero means "unknown", and callers fall back to
+	// treating the finding as a single point.
+	EndLine   int
+	EndColumn int
+}
+
+// String renders a Finding the way the CLI prints it in text mode.
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s:%d:%d %s", f.Severity, f.File, f.Line, f.Column, f.Message)
+}
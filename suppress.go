@@ -0,0 +1,64 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+)
+
+// ignoreCommentRe matches a `//skillguard:ignore SG-EXEC-001[,SG-...]`
+// suppression comment, optionally trailing code on the same line.
+var ignoreCommentRe = regexp.MustCompile(`//\s*skillguard:ignore(?:\s+([\w,-]+)|\s*$)`)
+
+// suppressedLines maps a 1-based line number to the set of rule IDs
+// suppressed on that line by an inline comment. An empty rule set
+// (bare `//skillguard:ignore` with no IDs) suppresses every rule.
+func suppressedLines(fset *token.FileSet, file *ast.File) map[int]map[string]bool {
+	out := make(map[int]map[string]bool)
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			m := ignoreCommentRe.FindStringSubmatch(c.Text)
+			if m == nil {
+				continue
+			}
+			line := fset.Position(c.Pos()).Line
+			rules := out[line]
+			if rules == nil {
+				rules = make(map[string]bool)
+				out[line] = rules
+			}
+			for _, id := range splitRuleIDs(m[1]) {
+				rules[id] = true
+			}
+		}
+	}
+	return out
+}
+
+func splitRuleIDs(s string) []string {
+	var ids []string
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ',' {
+			if i > start {
+				ids = append(ids, s[start:i])
+			}
+			start = i + 1
+		}
+	}
+	return ids
+}
+
+// filterSuppressed drops findings whose line carries a matching
+// `//skillguard:ignore` comment.
+func filterSuppressed(findings []Finding, suppressed map[int]map[string]bool) []Finding {
+	out := findings[:0]
+	for _, f := range findings {
+		rules, ok := suppressed[f.Line]
+		if ok && (len(rules) == 0 || rules[f.RuleID]) {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package taint
+
+// sanitizer identifies a call that clears taint from its result,
+// regardless of whether its own arguments were tainted.
+type sanitizer struct {
+	pkg string
+	fn  string
+}
+
+var sanitizers = []sanitizer{
+	{pkg: "html", fn: "EscapeString"},
+	{pkg: "strconv", fn: "Atoi"},
+	{pkg: "strconv", fn: "ParseInt"},
+	{pkg: "strconv", fn: "ParseFloat"},
+	{pkg: "strconv", fn: "ParseBool"},
+	{pkg: "regexp", fn: "MustCompile"},
+}
+
+// extraSanitizers holds user-configured allowlist functions, set via
+// Options.Sanitizers. They are matched the same way as the built-ins.
+var extraSanitizers []sanitizer
+
+// isSanitizer reports whether a call to pkg.fn clears taint.
+func isSanitizer(pkg, fn string) bool {
+	for _, s := range sanitizers {
+		if s.pkg == pkg && s.fn == fn {
+			return true
+		}
+	}
+	for _, s := range extraSanitizers {
+		if s.pkg == pkg && s.fn == fn {
+			return true
+		}
+	}
+	return false
+}
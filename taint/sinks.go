@@ -0,0 +1,68 @@
+package taint
+
+// sink identifies a call where receiving a tainted argument is a
+// finding. argIndex is the zero-based position of the dangerous
+// argument within the call's Go-level argument list (not counting a
+// method receiver).
+type sink struct {
+	pkg      string
+	fn       string
+	argIndex int
+	ruleID   string
+	message  string
+}
+
+// sinks lists the dangerous operations SkillGuard's taint pass checks
+// tainted data against. Each entry mirrors one of the categories in
+// the detector's design: command injection, SQL injection, path
+// traversal, and open-redirect.
+var sinks = []sink{
+	{pkg: "os/exec", fn: "Command", argIndex: 0, ruleID: "SG-TAINT-EXEC", message: "tainted value reaches exec.Command"},
+	{pkg: "os/exec", fn: "CommandContext", argIndex: 1, ruleID: "SG-TAINT-EXEC", message: "tainted value reaches exec.CommandContext"},
+	{pkg: "syscall", fn: "Exec", argIndex: 0, ruleID: "SG-TAINT-EXEC", message: "tainted value reaches syscall.Exec"},
+	{pkg: "os", fn: "StartProcess", argIndex: 0, ruleID: "SG-TAINT-EXEC", message: "tainted value reaches os.StartProcess"},
+	{pkg: "database/sql", fn: "(*DB).Query", argIndex: 0, ruleID: "SG-TAINT-SQL", message: "tainted value reaches db.Query"},
+	{pkg: "database/sql", fn: "(*DB).Exec", argIndex: 0, ruleID: "SG-TAINT-SQL", message: "tainted value reaches db.Exec"},
+	{pkg: "os", fn: "Open", argIndex: 0, ruleID: "SG-TAINT-PATH", message: "tainted value reaches os.Open"},
+	{pkg: "os", fn: "ReadFile", argIndex: 0, ruleID: "SG-TAINT-PATH", message: "tainted value reaches os.ReadFile"},
+	{pkg: "net/http", fn: "Redirect", argIndex: 2, ruleID: "SG-TAINT-REDIRECT", message: "tainted value reaches http.Redirect"},
+}
+
+// matchSink returns the sink definition for pkg.fn, if any.
+func matchSink(pkg, fn string) (sink, bool) {
+	for _, s := range sinks {
+		if s.pkg == pkg && s.fn == fn {
+			return s, true
+		}
+	}
+	return sink{}, false
+}
+
+// summary describes how taint flows through a call that is neither a
+// source nor a sink but still needs to propagate its input taint to
+// its output, e.g. string-building helpers.
+type summary struct {
+	pkg  string
+	fn   string
+	args []int // which argument positions, if tainted, taint the result
+}
+
+var summaries = []summary{
+	{pkg: "fmt", fn: "Sprintf", args: []int{1, 2, 3, 4, 5, 6, 7, 8}}, // format string (0) is not propagated
+	{pkg: "strings", fn: "Join", args: []int{0}},
+	{pkg: "path", fn: "Join", args: []int{0, 1, 2, 3, 4}},
+	{pkg: "path", fn: "Clean", args: []int{0}},
+	{pkg: "path/filepath", fn: "Join", args: []int{0, 1, 2, 3, 4}},
+	{pkg: "path/filepath", fn: "Clean", args: []int{0}},
+}
+
+// matchSummary returns the hand-written propagation rule for pkg.fn,
+// if one is known.
+func matchSummary(pkg, fn string) (summary, bool) {
+	for _, s := range summaries {
+		if s.pkg == pkg && s.fn == fn {
+			return s, true
+		}
+	}
+	return summary{}, false
+}
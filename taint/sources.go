@@ -0,0 +1,32 @@
+package taint
+
+// source identifies a call whose result (or, for methods, receiver)
+// should be treated as attacker-controlled.
+type source struct {
+	// pkg is the import path of the function or method's package.
+	pkg string
+	// fn is the unqualified function or method name.
+	fn string
+}
+
+// sources lists every HTTP/CLI/env/stdin entry point SkillGuard treats
+// as untrusted. Anything reachable from one of these taints every
+// value it touches until it hits a sanitizer or a sink.
+var sources = []source{
+	{pkg: "net/http", fn: "(*Request).FormValue"},
+	{pkg: "net/url", fn: "(*Values).Get"},
+	{pkg: "os", fn: "Args"},
+	{pkg: "os", fn: "Getenv"},
+	{pkg: "os", fn: "LookupEnv"},
+	{pkg: "bufio", fn: "(*Scanner).Text"},
+}
+
+// isSource reports whether a call to pkg.fn should seed taint.
+func isSource(pkg, fn string) bool {
+	for _, s := range sources {
+		if s.pkg == pkg && s.fn == fn {
+			return true
+		}
+	}
+	return false
+}
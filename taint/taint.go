@@ -0,0 +1,411 @@
+// Package taint performs taint analysis over Go source, tracking
+// values from HTTP/CLI/env/stdin sources to command/SQL/path/redirect
+// sinks.
+//
+// It builds an SSA form of the target package with
+// golang.org/x/tools/go/ssa and walks the instructions of every
+// package-level function and of the closures declared directly in
+// them, propagating a taint bit (and the chain of positions that
+// produced it) across assignments, string concatenation, known
+// propagating calls, and memory loads/stores. A value stops being
+// tainted the moment it passes through a configured sanitizer.
+//
+// Each function is analysed on its own: a call passes taint from its
+// arguments to its result, but taint is not followed into a callee's
+// body, from a caller into a callee's parameters, or back out through
+// a callee's return. Methods declared on types are not walked.
+package taint
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// Severity mirrors the top-level package's severity scale so callers
+// don't need to import it just to read a Finding.
+type Severity string
+
+// Finding is a single tainted-source-reaches-sink report.
+type Finding struct {
+	RuleID   string
+	Severity Severity
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	// Path lists the instruction positions the taint flowed through,
+	// from the originating source call to the sink call, so a finding
+	// can be reproduced without re-running the analysis.
+	Path []string
+}
+
+// Options configures an analysis run beyond the built-in source, sink
+// and sanitizer catalogues.
+type Options struct {
+	// Sanitizers names additional "pkg.Func" allowlist functions that
+	// clear taint from their result.
+	Sanitizers []string
+}
+
+// Analyze loads the package containing filename, builds its SSA form,
+// and returns every tainted-source-to-sink finding. It uses the
+// default source/sink/sanitizer catalogue; use AnalyzeWithOptions to
+// extend the sanitizer list.
+func Analyze(filename string) ([]Finding, error) {
+	return AnalyzeWithOptions(filename, Options{})
+}
+
+// AnalyzeWithOptions is Analyze with caller-supplied extra
+// sanitizers.
+func AnalyzeWithOptions(filename string, opts Options) ([]Finding, error) {
+	return AnalyzeOverlay(filename, nil, opts)
+}
+
+// AnalyzeOverlay is AnalyzeWithOptions, but unsaved buffer contents in
+// overlay (keyed by absolute file path, same convention as
+// packages.Config.Overlay) are used in place of what's on disk. The
+// LSP server in skillguard/lsp uses this to re-check a file as the
+// user types, without writing it to disk first.
+func AnalyzeOverlay(filename string, overlay map[string][]byte, opts Options) ([]Finding, error) {
+	extraSanitizers = extraSanitizers[:0]
+	for _, qualified := range opts.Sanitizers {
+		pkg, fn := splitQualified(qualified)
+		extraSanitizers = append(extraSanitizers, sanitizer{pkg: pkg, fn: fn})
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+			packages.NeedImports | packages.NeedTypes | packages.NeedTypesInfo |
+			packages.NeedSyntax | packages.NeedDeps,
+		Overlay: overlay,
+	}
+	pkgs, err := packages.Load(cfg, "file="+filename)
+	if err != nil {
+		return nil, fmt.Errorf("loading package for %s: %w", filename, err)
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no package found for %s", filename)
+	}
+
+	prog, ssaPkgs := ssautil.AllPackages(pkgs, ssa.SanityCheckFunctions)
+	prog.Build()
+
+	var findings []Finding
+	for _, ssaPkg := range ssaPkgs {
+		if ssaPkg == nil {
+			continue
+		}
+		for _, member := range ssaPkg.Members {
+			fn, ok := member.(*ssa.Function)
+			if !ok {
+				continue
+			}
+			findings = append(findings, analyzeFunction(fn)...)
+			for _, anon := range fn.AnonFuncs {
+				findings = append(findings, analyzeFunction(anon)...)
+			}
+		}
+	}
+	return findings, nil
+}
+
+// funcState tracks taint for a single function's SSA values
+// (registers) and addressable memory locations (allocs, fields) it
+// writes to. Re-run to a fixed point so taint carried around loop
+// back-edges via ssa.Phi is still caught.
+type funcState struct {
+	value map[ssa.Value]bool
+	addr  map[ssa.Value]bool
+	path  map[ssa.Value][]string
+}
+
+func analyzeFunction(fn *ssa.Function) []Finding {
+	if fn.Blocks == nil {
+		return nil // external/declared-only function, nothing to walk
+	}
+
+	st := &funcState{
+		value: make(map[ssa.Value]bool),
+		addr:  make(map[ssa.Value]bool),
+		path:  make(map[ssa.Value][]string),
+	}
+
+	var findings []Finding
+	// Iterate to a fixed point: a single pass misses taint that only
+	// becomes visible once a loop's Phi node settles.
+	for changed := true; changed; {
+		changed = false
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if stepInstruction(fn, instr, st, &findings) {
+					changed = true
+				}
+			}
+		}
+	}
+	return dedupeFindings(findings)
+}
+
+// stepInstruction evaluates one SSA instruction against the current
+// taint state, updating st and appending any sink findings. It
+// returns true if it changed st, so the caller can detect when a
+// fixed point has been reached.
+func stepInstruction(fn *ssa.Function, instr ssa.Instruction, st *funcState, findings *[]Finding) bool {
+	changed := false
+	mark := func(v ssa.Value, path []string) {
+		if v == nil || st.value[v] {
+			return
+		}
+		st.value[v] = true
+		st.path[v] = path
+		changed = true
+	}
+
+	switch ins := instr.(type) {
+	case *ssa.Call:
+		handleCall(fn, ins, ins.Common(), ins, st, findings, mark)
+	case *ssa.BinOp:
+		if ins.Op == token.ADD && (st.value[ins.X] || st.value[ins.Y]) {
+			mark(ins, appendPos(firstPath(st, ins.X, ins.Y), fn.Prog.Fset, ins.Pos(), "string concatenation"))
+		}
+	case *ssa.Phi:
+		for _, edge := range ins.Edges {
+			if st.value[edge] {
+				mark(ins, appendPos(st.path[edge], fn.Prog.Fset, ins.Pos(), "phi merge"))
+				break
+			}
+		}
+	case *ssa.Store:
+		if st.value[ins.Val] && !st.addr[ins.Addr] {
+			st.addr[ins.Addr] = true
+			st.path[ins.Addr] = st.path[ins.Val]
+			changed = true
+			// A store through an IndexAddr is how the SSA builder packs
+			// a variadic call's trailing arguments (and composite slice
+			// literals) into a backing array: arg0, arg1, ... each get
+			// their own IndexAddr+Store before the array is sliced. Mark
+			// the array/slice being indexed into as tainted too, so the
+			// *ssa.Slice below sees it once any element is tainted.
+			if idx, isIndexAddr := ins.Addr.(*ssa.IndexAddr); isIndexAddr {
+				mark(idx.X, appendPos(st.path[ins.Val], fn.Prog.Fset, ins.Pos(), "element store"))
+			}
+		}
+	case *ssa.UnOp:
+		if ins.Op == token.MUL {
+			if g, isGlobal := ins.X.(*ssa.Global); isGlobal && isGlobalSource(g) {
+				mark(ins, []string{fmt.Sprintf("%s: source %s.%s", fn.Prog.Fset.Position(ins.Pos()), pkgPathOfGlobal(g), g.Name())})
+			} else if st.addr[ins.X] && !st.value[ins] { // *ptr load
+				mark(ins, st.path[ins.X])
+			}
+		}
+	case *ssa.FieldAddr:
+		if st.addr[ins.X] && !st.addr[ssa.Value(ins)] {
+			st.addr[ins] = true
+			st.path[ins] = st.path[ins.X]
+			changed = true
+		}
+	case *ssa.IndexAddr:
+		// ins.X is addressable memory (st.addr) when it's a pointer to
+		// an array, but a tainted slice *value* (st.value) — e.g. the
+		// os.Args global itself — never gets an st.addr entry, since
+		// nothing ever stores into it. Indexing either one taints the
+		// element address the same way.
+		if (st.addr[ins.X] || st.value[ins.X]) && !st.addr[ssa.Value(ins)] {
+			st.addr[ins] = true
+			st.path[ins] = st.path[ins.X]
+			changed = true
+		}
+	case *ssa.Index:
+		if st.value[ins.X] {
+			mark(ins, appendPos(st.path[ins.X], fn.Prog.Fset, ins.Pos(), "index"))
+		}
+	case *ssa.Slice:
+		// Turns the tainted backing array built above (or a tainted
+		// slice/MakeSlice value sliced again, e.g. s[1:]) into a new
+		// slice value; that value is what actually gets passed as a
+		// variadic call's trailing argument, so without this a tainted
+		// fmt.Sprintf/strings.Join/path.Join argument never taints the
+		// slice the summary inspects.
+		if st.value[ins.X] {
+			mark(ins, appendPos(st.path[ins.X], fn.Prog.Fset, ins.Pos(), "slice"))
+		}
+	case *ssa.MakeInterface:
+		// Every variadic ...interface{} argument is boxed through a
+		// MakeInterface before it's stored into the backing array; skip
+		// this and a tainted fmt.Sprintf/Println argument never reaches
+		// the Store that feeds the array/slice propagation above.
+		if st.value[ins.X] {
+			mark(ins, appendPos(st.path[ins.X], fn.Prog.Fset, ins.Pos(), "interface conversion"))
+		}
+	}
+
+	return changed
+}
+
+// handleCall classifies a call as a source, sink, sanitizer, known
+// summary, or unknown, and updates taint state accordingly.
+func handleCall(fn *ssa.Function, call *ssa.Call, common *ssa.CallCommon, val ssa.Value, st *funcState, findings *[]Finding, mark func(ssa.Value, []string)) {
+	pkgPath, name, ok := calleeSignature(common)
+	pos := fn.Prog.Fset.Position(call.Pos())
+
+	if ok && isSanitizer(pkgPath, name) {
+		return // explicitly untainted, even if inputs were tainted
+	}
+
+	if ok && isSource(pkgPath, name) {
+		mark(val, []string{fmt.Sprintf("%s: source %s.%s", fn.Prog.Fset.Position(call.Pos()), pkgPath, name)})
+		return
+	}
+
+	if ok {
+		if sum, match := matchSummary(pkgPath, name); match {
+			for _, idx := range sum.args {
+				if idx < len(common.Args) && st.value[common.Args[idx]] {
+					mark(val, appendPos(st.path[common.Args[idx]], fn.Prog.Fset, call.Pos(), pkgPath+"."+name))
+					break
+				}
+			}
+			return // the summary alone decides which arguments propagate
+		}
+	}
+
+	// sk.argIndex doesn't count a method receiver, but a static method
+	// call's common.Args starts with it.
+	argOffset := 0
+	if ok && common.StaticCallee().Signature.Recv() != nil {
+		argOffset = 1
+	}
+
+	if ok {
+		if sk, match := matchSink(pkgPath, name); match && sk.argIndex+argOffset < len(common.Args) {
+			arg := common.Args[sk.argIndex+argOffset]
+			if st.value[arg] {
+				*findings = append(*findings, Finding{
+					RuleID:   sk.ruleID,
+					Severity: SeverityForRule(sk.ruleID),
+					Message:  sk.message,
+					File:     pos.Filename,
+					Line:     pos.Line,
+					Column:   pos.Column,
+					Path:     appendPos(st.path[arg], fn.Prog.Fset, call.Pos(), "sink "+pkgPath+"."+name),
+				})
+			}
+			return
+		}
+	}
+
+	// Unknown callee: conservatively propagate taint from any tainted
+	// argument (or receiver, which a static method call passes as its
+	// first argument) to the result. The receiver itself is not marked
+	// tainted by a tainted argument.
+	for _, arg := range common.Args {
+		if st.value[arg] {
+			mark(val, appendPos(st.path[arg], fn.Prog.Fset, call.Pos(), "unsummarized call"))
+			break
+		}
+	}
+}
+
+// calleeSignature returns the import path and name of a call's static
+// callee, e.g. ("os/exec", "Command") or ("net/http", "(*Request).FormValue").
+func calleeSignature(common *ssa.CallCommon) (pkgPath, name string, ok bool) {
+	callee := common.StaticCallee()
+	if callee == nil {
+		return "", "", false
+	}
+	if recv := callee.Signature.Recv(); recv != nil {
+		named, ok := baseType(recv.Type())
+		if !ok {
+			return "", "", false
+		}
+		return pkgPathOf(named), fmt.Sprintf("(*%s).%s", named.Obj().Name(), callee.Name()), true
+	}
+	if callee.Pkg == nil {
+		return "", "", false
+	}
+	return callee.Pkg.Pkg.Path(), callee.Name(), true
+}
+
+func baseType(t types.Type) (*types.Named, bool) {
+	if ptr, isPtr := t.(*types.Pointer); isPtr {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return named, ok
+}
+
+func pkgPathOf(named *types.Named) string {
+	if named.Obj().Pkg() == nil {
+		return ""
+	}
+	return named.Obj().Pkg().Path()
+}
+
+// isGlobalSource reports whether a read of package-level variable g
+// (e.g. os.Args) should seed taint, using the same source catalogue
+// as call-based sources.
+func isGlobalSource(g *ssa.Global) bool {
+	return isSource(pkgPathOfGlobal(g), g.Name())
+}
+
+func pkgPathOfGlobal(g *ssa.Global) string {
+	if g.Pkg == nil || g.Pkg.Pkg == nil {
+		return ""
+	}
+	return g.Pkg.Pkg.Path()
+}
+
+func firstPath(st *funcState, vs ...ssa.Value) []string {
+	for _, v := range vs {
+		if p, found := st.path[v]; found {
+			return p
+		}
+	}
+	return nil
+}
+
+func appendPos(path []string, fset *token.FileSet, pos token.Pos, label string) []string {
+	return append(append([]string{}, path...), fmt.Sprintf("%s: %s", fset.Position(pos), label))
+}
+
+// SeverityForRule maps a taint rule ID to the severity SkillGuard
+// reports it at; command and SQL injection outrank path traversal and
+// open redirect.
+func SeverityForRule(ruleID string) Severity {
+	switch ruleID {
+	case "SG-TAINT-EXEC", "SG-TAINT-SQL":
+		return "CRITICAL"
+	case "SG-TAINT-PATH":
+		return "HIGH"
+	default:
+		return "MEDIUM"
+	}
+}
+
+func splitQualified(qualified string) (pkg, fn string) {
+	for i := len(qualified) - 1; i >= 0; i-- {
+		if qualified[i] == '.' {
+			return qualified[:i], qualified[i+1:]
+		}
+	}
+	return "", qualified
+}
+
+func dedupeFindings(in []Finding) []Finding {
+	seen := make(map[string]bool, len(in))
+	out := make([]Finding, 0, len(in))
+	for _, f := range in {
+		key := fmt.Sprintf("%s:%d:%d:%s", f.File, f.Line, f.Column, f.RuleID)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, f)
+	}
+	return out
+}
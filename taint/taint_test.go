@@ -0,0 +1,65 @@
+package taint_test
+
+import (
+	"testing"
+
+	"skillguard/taint"
+)
+
+func ruleIDs(findings []taint.Finding) []string {
+	ids := make([]string, len(findings))
+	for i, f := range findings {
+		ids[i] = f.RuleID
+	}
+	return ids
+}
+
+func TestAnalyzeSourceToSink(t *testing.T) {
+	findings, err := taint.Analyze("testdata/sourcesink/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].RuleID != "SG-TAINT-EXEC" {
+		t.Fatalf("got %v, want exactly one SG-TAINT-EXEC finding", ruleIDs(findings))
+	}
+}
+
+func TestAnalyzeSanitizerBreaksTaint(t *testing.T) {
+	findings, err := taint.Analyze("testdata/sanitizerbreak/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("got %v, want no findings: html.EscapeString should have cleared taint before os.Open", ruleIDs(findings))
+	}
+}
+
+func TestAnalyzeTaintThroughSummary(t *testing.T) {
+	findings, err := taint.Analyze("testdata/summaryflow/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].RuleID != "SG-TAINT-EXEC" {
+		t.Fatalf("got %v, want exactly one SG-TAINT-EXEC finding flowing through fmt.Sprintf", ruleIDs(findings))
+	}
+}
+
+func TestAnalyzeIndexedArgsSource(t *testing.T) {
+	findings, err := taint.Analyze("testdata/argsindex/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].RuleID != "SG-TAINT-EXEC" {
+		t.Fatalf("got %v, want exactly one SG-TAINT-EXEC finding from os.Args[1]", ruleIDs(findings))
+	}
+}
+
+func TestAnalyzeLoopCarriedTaint(t *testing.T) {
+	findings, err := taint.Analyze("testdata/loopphi/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].RuleID != "SG-TAINT-EXEC" {
+		t.Fatalf("got %v, want exactly one SG-TAINT-EXEC finding from the loop-carried phi merge", ruleIDs(findings))
+	}
+}
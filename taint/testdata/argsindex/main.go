@@ -0,0 +1,17 @@
+// Package main is a fixture for TestAnalyzeIndexedArgsSource: the
+// tainted value reaches exec.Command only after being pulled out of
+// os.Args by index, so the finding only shows up if indexing a
+// tainted slice value propagates taint to the loaded element.
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func run() {
+	cmd := os.Args[1]
+	exec.Command(cmd).Run()
+}
+
+func main() {}
@@ -0,0 +1,23 @@
+// Package main is a fixture for TestAnalyzeLoopCarriedTaint: the
+// tainted value only reaches cmd on some loop iterations, so it's
+// only visible to the taint engine once the ssa.Phi node for cmd
+// settles across the loop's back-edge, which needs the fixed-point
+// iteration in analyzeFunction to catch.
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func run(args []string) {
+	cmd := "safe"
+	for _, a := range args {
+		if a == "x" {
+			cmd = os.Getenv("CMD")
+		}
+	}
+	exec.Command(cmd).Run()
+}
+
+func main() {}
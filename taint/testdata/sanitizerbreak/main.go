@@ -0,0 +1,18 @@
+// Package main is a fixture for TestAnalyzeSanitizerBreaksTaint: the
+// value read from os.Getenv passes through html.EscapeString, a
+// configured sanitizer, before reaching os.Open, so no finding should
+// be reported for it.
+package main
+
+import (
+	"html"
+	"os"
+)
+
+func run() {
+	raw := os.Getenv("PATH_PARAM")
+	safe := html.EscapeString(raw)
+	os.Open(safe)
+}
+
+func main() {}
@@ -0,0 +1,16 @@
+// Package main is a fixture for TestAnalyzeSourceToSink: a value read
+// from an HTTP request reaches exec.Command with no sanitizer in
+// between, so the taint engine should report SG-TAINT-EXEC.
+package main
+
+import (
+	"net/http"
+	"os/exec"
+)
+
+func handle(r *http.Request) {
+	cmd := r.FormValue("cmd")
+	exec.Command(cmd).Run()
+}
+
+func main() {}
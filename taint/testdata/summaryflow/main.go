@@ -0,0 +1,20 @@
+// Package main is a fixture for TestAnalyzeTaintThroughSummary: the
+// tainted value never reaches exec.Command directly, only after
+// fmt.Sprintf folds it into a formatted command string, so the
+// finding only shows up if the fmt.Sprintf summary actually marks its
+// result tainted.
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+)
+
+func handle(r *http.Request) {
+	name := r.FormValue("name")
+	cmd := fmt.Sprintf("echo %s", name)
+	exec.Command(cmd).Run()
+}
+
+func main() {}
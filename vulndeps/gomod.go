@@ -0,0 +1,70 @@
+// Package vulndeps audits a Go module's dependencies against the
+// OSV.dev vulnerability database, with an optional reachability pass
+// that only surfaces advisories whose module has at least one package
+// reachable from main. Reachability is decided per package; the
+// individual vulnerable symbols an advisory names are not checked.
+package vulndeps
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Module is one require-d dependency from go.mod, at the version its
+// require line names. go.sum is not read.
+type Module struct {
+	Path    string
+	Version string
+}
+
+// ParseGoMod reads the require block of a go.mod file. It's a
+// deliberately small parser: SkillGuard only needs module path and
+// version pairs, not the full module graph golang.org/x/mod/modfile
+// models.
+func ParseGoMod(path string) ([]Module, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var modules []Module
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "require (":
+			inBlock = true
+			continue
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case inBlock:
+			if m, ok := parseRequireLine(line); ok {
+				modules = append(modules, m)
+			}
+		case strings.HasPrefix(line, "require "):
+			if m, ok := parseRequireLine(strings.TrimPrefix(line, "require ")); ok {
+				modules = append(modules, m)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return modules, nil
+}
+
+// parseRequireLine parses a single `path version` entry, ignoring a
+// trailing `// indirect` comment.
+func parseRequireLine(line string) (Module, bool) {
+	line = strings.TrimSpace(strings.SplitN(line, "//", 2)[0])
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return Module{}, false
+	}
+	return Module{Path: fields[0], Version: fields[1]}, true
+}
@@ -0,0 +1,267 @@
+package vulndeps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const osvBatchURL = "https://api.osv.dev/v1/querybatch"
+const osvVulnURL = "https://api.osv.dev/v1/vulns/"
+
+// Advisory is one matching OSV record for a dependency.
+type Advisory struct {
+	Module    Module
+	ID        string   // e.g. GHSA-xxxx or CVE-xxxx
+	Aliases   []string // the other of GHSA/CVE, when OSV links them
+	Summary   string
+	FixedIn   string
+	Reachable bool // set by the reachability pass; false until then
+	Checkable bool // true once a callgraph reachability check ran
+}
+
+type osvQuery struct {
+	Package osvPackage `json:"package"`
+	Version string     `json:"version"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type osvBatchRequest struct {
+	Queries []osvQuery `json:"queries"`
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []osvVuln `json:"vulns"`
+	} `json:"results"`
+}
+
+type osvVuln struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases"`
+	Summary  string   `json:"summary"`
+	Affected []struct {
+		Ranges []struct {
+			Events []struct {
+				Fixed string `json:"fixed"`
+			} `json:"events"`
+		} `json:"ranges"`
+	} `json:"affected"`
+}
+
+// QueryOnline sends one batched request to the OSV.dev API for all
+// modules, then hydrates each id the batch returns with a GET to
+// /v1/vulns/{id}: querybatch only ever returns {id, modified} per
+// vuln, never summary, aliases, or affected ranges, so without this
+// every online-mode advisory would report an empty summary and no
+// fixed version.
+func QueryOnline(modules []Module) ([]Advisory, error) {
+	req := osvBatchRequest{}
+	for _, m := range modules {
+		req.Queries = append(req.Queries, osvQuery{
+			Package: osvPackage{Name: m.Path, Ecosystem: "Go"},
+			Version: m.Version,
+		})
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encoding OSV batch request: %w", err)
+	}
+
+	resp, err := http.Post(osvBatchURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("querying OSV: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV query failed: %s", resp.Status)
+	}
+
+	var batch osvBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return nil, fmt.Errorf("decoding OSV response: %w", err)
+	}
+	return hydrateAdvisories(modules, batch)
+}
+
+// getVulnRecord fetches the full OSV vulnerability record for id,
+// the shape querybatch omits (summary, aliases, affected/ranges).
+func getVulnRecord(id string) (osvVuln, error) {
+	resp, err := http.Get(osvVulnURL + id)
+	if err != nil {
+		return osvVuln{}, fmt.Errorf("fetching OSV record %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return osvVuln{}, fmt.Errorf("fetching OSV record %s: %s", id, resp.Status)
+	}
+	var v osvVuln
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return osvVuln{}, fmt.Errorf("decoding OSV record %s: %w", id, err)
+	}
+	return v, nil
+}
+
+// osvDumpRecord is one advisory as OSV publishes it in its bulk dump
+// (https://osv.dev/docs/#tag/vulnerability_schema, one JSON record per
+// vulnerability in all.zip or a per-ecosystem zip) — not the
+// position-indexed, input-keyed shape the querybatch API returns.
+type osvDumpRecord struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases"`
+	Summary  string   `json:"summary"`
+	Affected []struct {
+		Package struct {
+			Name      string `json:"name"`
+			Ecosystem string `json:"ecosystem"`
+		} `json:"package"`
+		Versions []string `json:"versions"`
+		Ranges   []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced string `json:"introduced"`
+				Fixed      string `json:"fixed"`
+			} `json:"events"`
+		} `json:"ranges"`
+	} `json:"affected"`
+}
+
+// QueryOffline matches modules against a local OSV database dump: a
+// flat JSON array of osvDumpRecord, the shape of the per-ecosystem
+// dump OSV publishes (e.g. the Go ecosystem's all.zip unpacked and
+// concatenated into one array), instead of calling the network, for
+// air-gapped CI.
+func QueryOffline(modules []Module, dumpPath string) ([]Advisory, error) {
+	data, err := os.ReadFile(dumpPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading OSV dump %s: %w", dumpPath, err)
+	}
+	var records []osvDumpRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("parsing OSV dump %s: %w", dumpPath, err)
+	}
+
+	var advisories []Advisory
+	for _, m := range modules {
+		for _, rec := range records {
+			fixedIn, affected := moduleAffected(m, rec)
+			if !affected {
+				continue
+			}
+			advisories = append(advisories, Advisory{
+				Module:  m,
+				ID:      rec.ID,
+				Aliases: rec.Aliases,
+				Summary: rec.Summary,
+				FixedIn: fixedIn,
+			})
+		}
+	}
+	return advisories, nil
+}
+
+// moduleAffected reports whether rec lists m as affected, either by
+// an exact enumerated version or by m's version falling inside a
+// SEMVER range with no later "fixed" event, and returns the fixed
+// version for that range if there is one.
+func moduleAffected(m Module, rec osvDumpRecord) (fixedIn string, affected bool) {
+	for _, aff := range rec.Affected {
+		if aff.Package.Ecosystem != "Go" || aff.Package.Name != m.Path {
+			continue
+		}
+		for _, v := range aff.Versions {
+			if v == m.Version {
+				return "", true
+			}
+		}
+		for _, r := range aff.Ranges {
+			if r.Type != "SEMVER" {
+				continue
+			}
+			var introduced, fixed string
+			for _, e := range r.Events {
+				if e.Introduced != "" {
+					introduced = e.Introduced
+				}
+				if e.Fixed != "" {
+					fixed = e.Fixed
+				}
+			}
+			if versionInSemverRange(m.Version, introduced, fixed) {
+				return fixed, true
+			}
+		}
+	}
+	return "", false
+}
+
+// versionInSemverRange reports whether version falls within
+// [introduced, fixed) as OSV's SEMVER range events define it; an
+// empty or "0" introduced means "from the beginning", and an empty
+// fixed means "still affected".
+func versionInSemverRange(version, introduced, fixed string) bool {
+	v := canonicalSemver(version)
+	if introduced != "" && introduced != "0" && semver.Compare(v, canonicalSemver(introduced)) < 0 {
+		return false
+	}
+	if fixed != "" && semver.Compare(v, canonicalSemver(fixed)) >= 0 {
+		return false
+	}
+	return true
+}
+
+func canonicalSemver(v string) string {
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	return v
+}
+
+// hydrateAdvisories turns a querybatch response's bare ids into full
+// Advisory records, fetching each one's summary/aliases/fixed
+// versions with getVulnRecord.
+func hydrateAdvisories(modules []Module, batch osvBatchResponse) ([]Advisory, error) {
+	var advisories []Advisory
+	for i, result := range batch.Results {
+		if i >= len(modules) {
+			break
+		}
+		for _, v := range result.Vulns {
+			detail, err := getVulnRecord(v.ID)
+			if err != nil {
+				return nil, err
+			}
+			advisories = append(advisories, Advisory{
+				Module:  modules[i],
+				ID:      v.ID,
+				Aliases: detail.Aliases,
+				Summary: detail.Summary,
+				FixedIn: firstFixedVersion(detail),
+			})
+		}
+	}
+	return advisories, nil
+}
+
+func firstFixedVersion(v osvVuln) string {
+	for _, a := range v.Affected {
+		for _, r := range a.Ranges {
+			for _, e := range r.Events {
+				if e.Fixed != "" {
+					return e.Fixed
+				}
+			}
+		}
+	}
+	return ""
+}
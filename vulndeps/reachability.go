@@ -0,0 +1,94 @@
+package vulndeps
+
+import (
+	"strings"
+
+	"golang.org/x/tools/go/callgraph"
+	"golang.org/x/tools/go/callgraph/rta"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// MarkReachable builds a call graph for the program rooted at
+// mainDir and flips Reachable/Checkable on every advisory whose
+// module package is actually called from main, so noise from unused
+// transitive dependencies doesn't show up as urgent.
+//
+// It uses RTA (Rapid Type Analysis) rather than VTA: RTA is cheaper
+// and, since it only needs a yes/no per package rather than a
+// precise per-callsite graph, its extra conservatism doesn't cost
+// much precision here.
+func MarkReachable(mainDir string, advisories []Advisory) ([]Advisory, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+			packages.NeedImports | packages.NeedTypes | packages.NeedTypesInfo |
+			packages.NeedSyntax | packages.NeedDeps,
+		Dir: mainDir,
+	}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return advisories, err
+	}
+
+	prog, ssaPkgs := ssautil.AllPackages(pkgs, ssa.SanityCheckFunctions)
+	prog.Build()
+
+	var mains []*ssa.Package
+	for _, p := range ssaPkgs {
+		if p != nil && p.Pkg.Name() == "main" {
+			mains = append(mains, p)
+		}
+	}
+	if len(mains) == 0 {
+		return advisories, nil
+	}
+
+	result := rta.Analyze(mainFuncs(mains), true)
+	reachablePkgs := reachablePackagePaths(result.CallGraph)
+
+	out := make([]Advisory, len(advisories))
+	for i, a := range advisories {
+		a.Checkable = true
+		a.Reachable = reachablePkgs[a.Module.Path] || hasReachableSubpackage(reachablePkgs, a.Module.Path)
+		out[i] = a
+	}
+	return out, nil
+}
+
+func mainFuncs(mains []*ssa.Package) []*ssa.Function {
+	var fns []*ssa.Function
+	for _, m := range mains {
+		if f := m.Func("main"); f != nil {
+			fns = append(fns, f)
+		}
+		if f := m.Func("init"); f != nil {
+			fns = append(fns, f)
+		}
+	}
+	return fns
+}
+
+func reachablePackagePaths(cg *callgraph.Graph) map[string]bool {
+	reached := make(map[string]bool)
+	for fn, node := range cg.Nodes {
+		if fn == nil || fn.Pkg == nil || len(node.In) == 0 {
+			continue
+		}
+		reached[fn.Pkg.Pkg.Path()] = true
+	}
+	return reached
+}
+
+// hasReachableSubpackage reports whether any reached package path is
+// the module path itself or one of its subpackages, since an OSV
+// advisory is keyed by module path but the call graph is keyed by
+// package import path.
+func hasReachableSubpackage(reachable map[string]bool, modulePath string) bool {
+	for pkg := range reachable {
+		if pkg == modulePath || strings.HasPrefix(pkg, modulePath+"/") {
+			return true
+		}
+	}
+	return false
+}